@@ -5,16 +5,19 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"flag"
-	"fmt"
 	"log"
 	"net/http"
 	"time"
 
 	"eth2-crawler/crawler"
+	"eth2-crawler/crawler/p2p"
 	"eth2-crawler/graph"
 	"eth2-crawler/graph/generated"
+	"eth2-crawler/models"
 	"eth2-crawler/resolver/ipdata"
+	eventStore "eth2-crawler/store/event/mongo"
 	peerStore "eth2-crawler/store/peerstore/mongo"
 	recordStore "eth2-crawler/store/record/mongo"
 	"eth2-crawler/utils/config"
@@ -43,23 +46,47 @@ func main() {
 		log.Fatalf("error Initializing the record store: %s", err.Error())
 	}
 
+	eventStore, err := eventStore.New(cfg.Database)
+	if err != nil {
+		log.Fatalf("error Initializing the event store: %s", err.Error())
+	}
+
 	resolverService, err := ipdata.New(cfg.Resolver.APIKey, time.Duration(cfg.Resolver.Timeout)*time.Second)
 	if err != nil {
 		log.Fatalf("error Initializing the ip resolver: %s", err.Error())
 	}
 
-	// TODO collect config from a config files or from command args and pass to Start()
-	go crawler.Start(peerStore, historyStore, resolverService)
+	networks, err := models.NewNetworks(cfg.Networks)
+	if err != nil {
+		log.Fatalf("error Initializing the networks: %s", err.Error())
+	}
+
+	forkRequirements, err := models.NewForkRequirements(cfg.ForkReadiness, networks)
+	if err != nil {
+		log.Fatalf("error Initializing the fork readiness: %s", err.Error())
+	}
+
+	uaParser, err := models.NewUserAgentParser(cfg.UserAgentRules)
+	if err != nil {
+		log.Fatalf("error Initializing the user agent parser: %s", err.Error())
+	}
+
+	metrics := crawler.Start(cfg.Crawler, peerStore, historyStore, eventStore, resolverService, networks, uaParser)
 
-	srv := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: graph.NewResolver(peerStore, historyStore)}))
+	srv := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: graph.NewResolver(peerStore, historyStore, networks, forkRequirements, uaParser)}))
 
 	router := http.NewServeMux()
 	// TODO: make playground accessible only in Dev mode
 	router.Handle("/", playground.Handler("GraphQL playground", "/query"))
-	router.Handle("/query", srv)
+	// admin mutations need the ADMIN_TOKEN as bearer token
+	router.Handle("/query", server.AdminAuth(cfg.Server.AdminToken, srv))
 	// TODO: setup proper status handler
 	router.HandleFunc("/status", func(w http.ResponseWriter, r *http.Request) {
-		fmt.Fprintf(w, "{ \"status\": \"up\" }")
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(struct {
+			Status      string                 `json:"status"`
+			Connections *p2p.ConnectionMetrics `json:"connections"`
+		}{"up", metrics.ConnectionMetrics()})
 	})
 
 	server.Start(context.TODO(), cfg.Server, router)
@@ -6,33 +6,56 @@ package crawl
 import (
 	"context"
 	"crypto/ecdsa"
+	"errors"
 	"eth2-crawler/crawler/p2p"
 	reqresp "eth2-crawler/crawler/rpc/request"
 	"eth2-crawler/crawler/util"
 	"eth2-crawler/graph/model"
 	"eth2-crawler/models"
 	ipResolver "eth2-crawler/resolver"
+	"eth2-crawler/store/event"
 	"eth2-crawler/store/peerstore"
 	"eth2-crawler/store/record"
+	"eth2-crawler/utils/config"
+	"fmt"
+	"sync"
 	"time"
 
 	"github.com/protolambda/zrnt/eth2/beacon/common"
 
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/libp2p/go-libp2p/core/peer"
 )
 
+// probeTag protects the connections of the peers being probed from the connection manager
+const probeTag = "probe"
+
+// errTooManyPeers is returned when the peer refuses the crawler for having too many peers
+var errTooManyPeers = errors.New("peer has too many peers")
+
 type crawler struct {
-	disc            resolver
-	peerStore       peerstore.Provider
-	historyStore    record.Provider
-	ipResolver      ipResolver.Provider
-	iter            enode.Iterator
-	nodeCh          chan *enode.Node
-	privateKey      *ecdsa.PrivateKey
-	host            p2p.Host
-	jobs            chan *models.Peer
-	jobsConcurrency int
+	disc              resolver
+	peerStore         peerstore.Provider
+	historyStore      record.Provider
+	eventStore        event.Provider
+	ipResolver        ipResolver.Provider
+	iter              enode.Iterator
+	nodeCh            chan *enode.Node
+	privateKey        *ecdsa.PrivateKey
+	host              p2p.Host
+	networks          models.Networks
+	jobs              chan *models.Peer
+	jobsConcurrency   int
+	pollInterval      time.Duration
+	fullProbeInterval time.Duration
+	schedule          *scheduler
+	syncThresholds    *models.SyncThresholds
+	uaParser          *models.UserAgentParser
+
+	// inflight holds the peers picked for a probe until their job is done
+	inflightMu sync.Mutex
+	inflight   map[peer.ID]struct{}
 }
 
 // resolver holds methods of discovery v5
@@ -41,20 +64,33 @@ type resolver interface {
 }
 
 // newCrawler inits new crawler service
-func newCrawler(disc resolver, peerStore peerstore.Provider, historyStore record.Provider,
-	ipResolver ipResolver.Provider, privateKey *ecdsa.PrivateKey, iter enode.Iterator,
-	host p2p.Host, jobConcurrency int) *crawler {
+func newCrawler(cfg *config.Crawler, disc resolver, peerStore peerstore.Provider, historyStore record.Provider,
+	eventStore event.Provider, ipResolver ipResolver.Provider, privateKey *ecdsa.PrivateKey, iter enode.Iterator,
+	host p2p.Host, networks models.Networks, uaParser *models.UserAgentParser) *crawler {
+	thresholds := &models.SyncThresholds{
+		Synced:  *cfg.SyncedMaxLag,
+		Syncing: *cfg.SyncingMaxLag,
+		Stale:   *cfg.StaleMaxLag,
+	}
 	c := &crawler{
-		disc:            disc,
-		peerStore:       peerStore,
-		historyStore:    historyStore,
-		ipResolver:      ipResolver,
-		privateKey:      privateKey,
-		iter:            iter,
-		nodeCh:          make(chan *enode.Node),
-		host:            host,
-		jobs:            make(chan *models.Peer, jobConcurrency),
-		jobsConcurrency: jobConcurrency,
+		disc:              disc,
+		peerStore:         peerStore,
+		historyStore:      historyStore,
+		eventStore:        eventStore,
+		ipResolver:        ipResolver,
+		privateKey:        privateKey,
+		iter:              iter,
+		nodeCh:            make(chan *enode.Node),
+		host:              host,
+		networks:          networks,
+		jobs:              make(chan *models.Peer, cfg.Concurrency),
+		jobsConcurrency:   cfg.Concurrency,
+		pollInterval:      time.Duration(cfg.PollInterval) * time.Second,
+		fullProbeInterval: time.Duration(cfg.FullProbeInterval) * time.Second,
+		schedule:          newScheduler(cfg),
+		syncThresholds:    thresholds,
+		uaParser:          uaParser,
+		inflight:          make(map[peer.ID]struct{}),
 	}
 	return c
 }
@@ -88,8 +124,8 @@ func (c *crawler) runIterator(ctx context.Context, doneCh chan enode.Iterator, i
 }
 
 func (c *crawler) storePeer(ctx context.Context, node *enode.Node) {
-	// only consider the node having tcp port exported
-	if node.TCP() == 0 {
+	// only consider the node having a tcp or quic port exported
+	if !util.HasLibp2pPort(node) {
 		return
 	}
 	// filter only eth2 nodes
@@ -97,17 +133,57 @@ func (c *crawler) storePeer(ctx context.Context, node *enode.Node) {
 	if err != nil { // not eth2 nodes
 		return
 	}
-	log.Debug("found a eth2 node", log.Ctx{"node": node})
+	// filter only nodes of the crawled networks
+	network := c.networks.Match(eth2Data)
+	if network == nil {
+		return
+	}
+	log.Debug("found a eth2 node", log.Ctx{"node": node, "network": network.Name})
 
 	// get basic info
-	peer, err := models.NewPeer(node, eth2Data)
+	peer, err := models.NewPeer(node, eth2Data, network.Name)
+	if err != nil {
+		return
+	}
+	peer.ForkName = network.ForkName(peer.ForkDigest)
+	stored, err := c.peerStore.View(ctx, peer.ID)
+	switch {
+	case errors.Is(err, peerstore.ErrPeerNotFound):
+		err = c.peerStore.Create(ctx, peer)
+		if err != nil {
+			log.Error("err inserting peer", log.Ctx{"err": err, "peer": peer.String()})
+		}
+	case err != nil:
+		log.Error("err getting peer", log.Ctx{"err": err, "peer_id": peer.ID})
+	case peer.Seq > stored.Seq:
+		c.updateRecord(ctx, stored, peer)
+	}
+}
+
+// updateRecord updates the stored peer with the fields of its newer node record
+// and records the changes as an event. The record fields only are written, a probe
+// of the peer in progress keeps its results
+func (c *crawler) updateRecord(ctx context.Context, stored, peer *models.Peer) {
+	// the peers stored before the sequence numbers were kept have none,
+	// their first record update backfills it and is not recorded
+	backfill := stored.Seq == 0
+	changes := stored.UpdateRecord(peer)
+	err := c.peerStore.UpdateRecord(ctx, stored)
+	if errors.Is(err, peerstore.ErrRecordChanged) {
+		log.Debug("newer peer record already stored", log.Ctx{"peer_id": peer.ID, "seq": peer.Seq})
+		return
+	}
 	if err != nil {
+		log.Error("err updating peer record", log.Ctx{"err": err, "peer_id": peer.ID})
+		return
+	}
+	log.Debug("peer record updated", log.Ctx{"peer_id": peer.ID, "seq": peer.Seq})
+	if backfill {
 		return
 	}
-	// save to db if not exists
-	err = c.peerStore.Create(ctx, peer)
+	err = c.eventStore.Create(ctx, models.NewEvent(peer.ID, models.EventRecordUpdated, changes))
 	if err != nil {
-		log.Error("err inserting peer", log.Ctx{"err": err, "peer": peer.String()})
+		log.Error("err inserting peer event", log.Ctx{"err": err, "peer_id": peer.ID})
 	}
 }
 
@@ -120,27 +196,19 @@ func (c *crawler) updatePeer(ctx context.Context) {
 		default:
 			c.selectPendingAndExecute(ctx)
 		}
-		time.Sleep(5 * time.Second)
+		time.Sleep(c.pollInterval)
 	}
 }
 
 func (c *crawler) selectPendingAndExecute(ctx context.Context) {
-	// get peers that was updated 24 hours ago
-	reqs, err := c.peerStore.ListForJob(ctx, time.Hour*24, c.jobsConcurrency)
+	// get peers due to a probe, skipping the ones already picked
+	reqs, err := c.peerStore.ListForJob(ctx, c.inflightPeers(), c.jobsConcurrency)
 	if err != nil {
 		log.Error("error getting list from peerstore", log.Ctx{"err": err})
 		return
 	}
 	for _, req := range reqs {
-		// update the pr, so it won't be picked again in 24 hours
-		// We have to update the LastUpdated field here and cannot rety on the worker to update it
-		// That is because the same request will be picked again when it is in worker.
-		req.LastUpdated = time.Now().Unix()
-		err = c.peerStore.Update(ctx, req)
-		if err != nil {
-			log.Error("error updating request", log.Ctx{"err": err})
-			continue
-		}
+		c.setInflight(req.ID, true)
 		select {
 		case <-ctx.Done():
 			log.Error("update selector stopped", log.Ctx{"err": ctx.Err()})
@@ -165,23 +233,55 @@ func (c *crawler) bgWorker(ctx context.Context) {
 			return
 		case req := <-c.jobs:
 			c.updatePeerInfo(ctx, req)
+			c.setInflight(req.ID, false)
 		}
 	}
 }
 
+func (c *crawler) inflightPeers() []peer.ID {
+	c.inflightMu.Lock()
+	defer c.inflightMu.Unlock()
+	ids := make([]peer.ID, 0, len(c.inflight))
+	for id := range c.inflight {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+func (c *crawler) setInflight(id peer.ID, inflight bool) {
+	c.inflightMu.Lock()
+	defer c.inflightMu.Unlock()
+	if inflight {
+		c.inflight[id] = struct{}{}
+	} else {
+		delete(c.inflight, id)
+	}
+}
+
 func (c *crawler) updatePeerInfo(ctx context.Context, peer *models.Peer) {
-	// update connection status, agent version, sync status
-	isConnectable := c.collectNodeInfoRetryer(ctx, peer)
-	if isConnectable {
-		peer.SetConnectionStatus(true)
-		peer.Score = models.ScoreGood
-		peer.LastConnected = time.Now().Unix()
-		// update geolocation
-		if peer.GeoLocation == nil {
-			c.updateGeolocation(ctx, peer)
+	// peers stored before the network was tracked are matched from their fork data
+	if peer.Network == "" {
+		network := c.networks.Match(&common.Eth2Data{
+			ForkDigest:      peer.ForkDigest,
+			NextForkVersion: peer.NextForkVersion,
+			NextForkEpoch:   common.Epoch(peer.NextForkEpoch),
+		})
+		if network != nil {
+			peer.Network = network.Name
 		}
+	}
+	// the fork names follow the configured schedule
+	if network := c.networks.ByName(peer.Network); network != nil {
+		peer.ForkName = network.ForkName(peer.CurrentForkDigest())
+	}
+	// check the peer is alive with a cheap udp ping first, the peers not answering
+	// are not dialed and back off like the failed probes
+	if err := c.checkUDPLiveness(peer); err != nil {
+		log.Debug("peer is not reachable over udp", log.Ctx{"err": err, "peer_id": peer.ID})
+		peer.SetFailure(models.FailureUDPUnreachable, err)
+		c.schedule.failure(peer)
 	} else {
-		peer.Score--
+		c.probe(ctx, peer)
 	}
 	// remove the node if it has bad score
 	if peer.Score <= models.ScoreBad {
@@ -192,66 +292,265 @@ func (c *crawler) updatePeerInfo(ctx context.Context, peer *models.Peer) {
 		}
 		return
 	}
+	peer.LastUpdated = time.Now().Unix()
 	err := c.peerStore.Update(ctx, peer)
+	if errors.Is(err, peerstore.ErrRecordChanged) {
+		// the probe results are dropped, the peer is due and probed again with its new record
+		log.Debug("peer record changed during the probe", log.Ctx{"peer_id": peer.ID})
+		return
+	}
 	if err != nil {
 		log.Error("failed on updating peerstore", log.Ctx{"err": err})
 	}
 }
 
-func (c *crawler) collectNodeInfoRetryer(ctx context.Context, peer *models.Peer) bool {
-	count := 0
-	var err error
-	var ag, pv string
-	for count < 20 {
-		time.Sleep(time.Second * 5)
-		count++
+// probe updates the connection status, agent version and sync status of the peer.
+// A single attempt is made, failed probes are retried by the scheduler
+func (c *crawler) probe(ctx context.Context, peer *models.Peer) {
+	start := time.Now().Unix()
+	// the connection is kept by the connection manager during the probe only
+	c.host.ConnManager().Protect(peer.ID, probeTag)
+	err := c.collectNodeInfo(ctx, peer)
+	c.disconnect(ctx, peer, err)
+	// the peers may say goodbye after a successful probe too
+	goodbye := c.updateGoodbye(peer, start)
+	switch {
+	case err == nil:
+		log.Info("successfully collected all info", peer.Log())
+		peer.SetConnectionStatus(true)
+		// update geolocation
+		c.updateGeolocation(ctx, peer)
+		c.schedule.success(peer)
+	case goodbye && peer.Goodbye.Reason == models.GoodbyeTooManyPeers:
+		// the peer is reachable but full
+		log.Debug("peer has too many peers", log.Ctx{"peer_id": peer.ID})
+		peer.SetConnectionStatus(true)
+		peer.SetFailure(models.FailureTooManyPeers, errTooManyPeers)
+		c.schedule.busy(peer)
+	default:
+		log.Debug("failed to collect peer info", log.Ctx{"err": err, "peer_id": peer.ID})
+		peer.SetFailure(failureReason(err), err)
+		c.schedule.failure(peer)
+	}
+}
 
-		err = c.host.Connect(ctx, *peer.GetPeerInfo())
-		if err != nil {
-			continue
-		}
-		// get status
-		var status *common.Status
-		status, err = c.host.FetchStatus(c.host.NewStream, ctx, peer, new(reqresp.SnappyCompression))
-		if err != nil || status == nil {
-			continue
-		}
-		ag, err = c.host.GetAgentVersion(peer.ID)
-		if err != nil {
-			continue
-		} else {
-			peer.SetUserAgent(ag)
+// checkUDPLiveness pings the peer through discv5 and stores the result.
+// Peers without a udp port can't be checked, they are reported alive
+func (c *crawler) checkUDPLiveness(peer *models.Peer) error {
+	if peer.UDPPort == 0 && peer.UDP6Port == 0 {
+		return nil
+	}
+	node, err := discoveryNode(peer)
+	if err != nil {
+		log.Error("unable to build peer enode", log.Ctx{"err": err, "peer_id": peer.ID})
+		return nil
+	}
+	start := time.Now()
+	err = c.disc.Ping(node)
+	peer.SetUDPLiveness(err == nil, time.Since(start))
+	return err
+}
+
+// discoveryNode returns the node of the signed record of the peer,
+// the peers stored before their record was kept are rebuilt from their fields
+func discoveryNode(peer *models.Peer) (*enode.Node, error) {
+	if peer.ENR == "" {
+		return peer.GetEnode()
+	}
+	return util.ParseNode(peer.ENR)
+}
+
+// disconnect says goodbye to the probed peer and closes the connection.
+// The peers on another network are told they are irrelevant
+func (c *crawler) disconnect(ctx context.Context, peer *models.Peer, probeErr error) {
+	c.host.ConnManager().Unprotect(peer.ID, probeTag)
+	reason := models.GoodbyeClientShutdown
+	if failureReason(probeErr) == models.FailureForkDigestMismatch {
+		reason = models.GoodbyeIrrelevantNetwork
+	}
+	if err := c.host.Disconnect(ctx, peer.ID, reason); err != nil {
+		log.Debug("unable to disconnect from peer", log.Ctx{"err": err, "peer_id": peer.ID})
+	}
+}
+
+// updateGoodbye sets the goodbye the peer sent since the given time and reports if there is one
+func (c *crawler) updateGoodbye(peer *models.Peer, since int64) bool {
+	goodbye, err := c.host.GetGoodbye(peer.ID)
+	if err != nil || goodbye.Time < since {
+		return false
+	}
+	peer.SetGoodbye(goodbye)
+	return true
+}
+
+// collectNodeInfo connects to the peer and collects its status and identity.
+// Already known peers are pinged and asked their status only, their metadata is fetched again
+// when its sequence number changed
+func (c *crawler) collectNodeInfo(ctx context.Context, peer *models.Peer) error {
+	err := c.host.Connect(ctx, *peer.GetPeerInfo())
+	if err != nil {
+		return connectError(err)
+	}
+	peer.SetTransports(c.host.GetTransports(peer.ID))
+	c.updateNegotiation(peer)
+	if c.isKnown(peer) {
+		err = c.pingKnownPeer(ctx, peer)
+	} else {
+		err = c.fullProbe(ctx, peer)
+	}
+	if err != nil {
+		return err
+	}
+	c.measureLatency(ctx, peer)
+	return nil
+}
+
+// isKnown checks the peer was fully probed with its current node record within the full probe interval
+func (c *crawler) isKnown(peer *models.Peer) bool {
+	if peer.UserAgent == nil || peer.MetaData == nil || peer.Chain == nil || peer.ProbedSeq != peer.Seq {
+		return false
+	}
+	return time.Since(time.Unix(peer.ProbedAt, 0)) < c.fullProbeInterval
+}
+
+// fullProbe collects the status of the peer, its identify details and metadata
+func (c *crawler) fullProbe(ctx context.Context, peer *models.Peer) error {
+	if err := c.updateStatus(ctx, peer); err != nil {
+		return err
+	}
+	if err := c.identifyPeer(ctx, peer); err != nil {
+		return err
+	}
+	peer.ProbedSeq = peer.Seq
+	peer.ProbedAt = time.Now().Unix()
+	return nil
+}
+
+// updateStatus collects the status of the peer and sets its chain and sync status
+func (c *crawler) updateStatus(ctx context.Context, peer *models.Peer) error {
+	status, err := c.host.FetchStatus(c.host.NewStream, ctx, peer, new(reqresp.SnappyCompression))
+	if err != nil {
+		return requestError(models.FailureStatusStreamReset, err)
+	}
+	network := c.networks.ByName(peer.Network)
+	if status.ForkDigest != peer.ForkDigest {
+		// the record digest lags the status one around the fork and blob schedule activations,
+		// only a status digest out of the network schedule is another network
+		if network == nil || network.ForkName(status.ForkDigest) == "" {
+			return newProbeError(models.FailureForkDigestMismatch,
+				fmt.Errorf("status fork digest %s, record fork digest %s", status.ForkDigest, peer.ForkDigest))
 		}
+		log.Debug("status and record fork digests differ", log.Ctx{
+			"peer_id": peer.ID, "status": status.ForkDigest, "record": peer.ForkDigest,
+		})
+	}
+	if network == nil {
+		peer.SetChainStatus(status, 0)
+	} else {
+		peer.SetChainStatus(status, network.CurrentEpoch())
+		// set the fork name from the status, which follows the activations, and the sync status
+		peer.ForkName = network.ForkName(status.ForkDigest)
+		peer.SetSyncStatus(uint64(status.HeadSlot), network, c.syncThresholds)
+	}
+	return nil
+}
 
-		pv, err = c.host.GetProtocolVersion(peer.ID)
-		if err != nil {
-			continue
-		} else {
-			peer.SetProtocolVersion(pv)
+// updateNegotiation sets the security protocol and stream muxer negotiated on the connection to the peer
+func (c *crawler) updateNegotiation(peer *models.Peer) {
+	security, err := c.host.GetSecurity(peer.ID)
+	if err != nil {
+		log.Debug("unable to get negotiated security", log.Ctx{"err": err, "peer_id": peer.ID})
+	}
+	muxer, err := c.host.GetMuxer(peer.ID)
+	if err != nil {
+		log.Debug("unable to get negotiated muxer", log.Ctx{"err": err, "peer_id": peer.ID})
+	}
+	peer.SetNegotiation(security, muxer)
+}
+
+// identifyPeer collects the peer identify details and its metadata
+func (c *crawler) identifyPeer(ctx context.Context, peer *models.Peer) error {
+	id, err := c.host.FetchIdentify(ctx, peer.ID)
+	if err != nil {
+		return newProbeError(models.FailureIdentifyTimeout, err)
+	}
+	peer.SetIdentify(id, c.uaParser)
+
+	// the metadata is optional, the peer is reachable even if it is not served
+	c.updateMetaData(ctx, peer)
+	return nil
+}
+
+// pingKnownPeer checks an identified peer is alive with the eth2 ping and refreshes its status,
+// the identify details are kept until the next full probe
+func (c *crawler) pingKnownPeer(ctx context.Context, peer *models.Peer) error {
+	seq, err := c.host.Ping(c.host.NewStream, ctx, peer, new(reqresp.SnappyCompression))
+	if err != nil {
+		return requestError(models.FailurePing, err)
+	}
+	if err = c.updateStatus(ctx, peer); err != nil {
+		return err
+	}
+	if seq != peer.MetaData.SeqNumber {
+		c.updateMetaData(ctx, peer)
+	}
+	return nil
+}
+
+// measureLatency adds the round trip time to the peer to its latency summary.
+// The eth2 ping is timed for the peers not serving the libp2p ping
+func (c *crawler) measureLatency(ctx context.Context, peer *models.Peer) {
+	rtt, err := c.host.MeasureRTT(ctx, peer.ID)
+	if err != nil {
+		start := time.Now()
+		if _, err = c.host.Ping(c.host.NewStream, ctx, peer, new(reqresp.SnappyCompression)); err != nil {
+			log.Debug("unable to measure peer latency", log.Ctx{"err": err, "peer_id": peer.ID})
+			return
 		}
-		// set sync status
-		peer.SetSyncStatus(int64(status.HeadSlot))
-		log.Info("successfully collected all info", peer.Log())
-		return true
-	}
-	// unsuccessful
-	log.Error("failed on retryer", log.Ctx{
-		"attempt": count,
-		"error":   err,
-	})
-	return false
+		rtt = time.Since(start)
+	}
+	peer.AddLatency(rtt)
 }
 
+func (c *crawler) updateMetaData(ctx context.Context, peer *models.Peer) {
+	md, err := c.host.FetchMetaData(c.host.NewStream, ctx, peer, new(reqresp.SnappyCompression))
+	if err != nil {
+		log.Debug("unable to fetch metadata", log.Ctx{"err": err, "peer_id": peer.ID})
+		return
+	}
+	peer.SetMetaData(md)
+}
+
+// updateGeolocation resolves the locations of the peer addresses not resolved yet
 func (c *crawler) updateGeolocation(ctx context.Context, peer *models.Peer) {
-	geoLoc, err := c.ipResolver.GetGeoLocation(ctx, peer.IP)
+	if peer.IP6 != "" && peer.GeoLocation6 == nil {
+		if geoLoc := c.resolveGeolocation(ctx, peer.IP6); geoLoc != nil {
+			peer.SetGeoLocation6(geoLoc)
+		}
+	}
+	if peer.GeoLocation != nil {
+		return
+	}
+	if peer.IP == "" {
+		// ipv6 only peer
+		peer.SetGeoLocation(peer.GeoLocation6)
+		return
+	}
+	if geoLoc := c.resolveGeolocation(ctx, peer.IP); geoLoc != nil {
+		peer.SetGeoLocation(geoLoc)
+	}
+}
+
+func (c *crawler) resolveGeolocation(ctx context.Context, ip string) *models.GeoLocation {
+	geoLoc, err := c.ipResolver.GetGeoLocation(ctx, ip)
 	if err != nil {
 		log.Error("unable to get geo information", log.Ctx{
 			"error":   err,
-			"ip_addr": peer.IP,
+			"ip_addr": ip,
 		})
-		return
+		return nil
 	}
-	peer.SetGeoLocation(geoLoc)
+	return geoLoc
 }
 
 func (c *crawler) insertToHistory() {
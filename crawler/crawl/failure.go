@@ -0,0 +1,65 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package crawl
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"eth2-crawler/models"
+)
+
+// probeError is the error of a probe step, classified by failure reason
+type probeError struct {
+	reason models.FailureReason
+	err    error
+}
+
+func (e *probeError) Error() string {
+	return fmt.Sprintf("%s: %v", e.reason, e.err)
+}
+
+func (e *probeError) Unwrap() error {
+	return e.err
+}
+
+func newProbeError(reason models.FailureReason, err error) error {
+	return &probeError{reason: reason, err: err}
+}
+
+// failureReason returns the reason of a probe error
+func failureReason(err error) models.FailureReason {
+	var pErr *probeError
+	if errors.As(err, &pErr) {
+		return pErr.reason
+	}
+	if errors.Is(err, errTooManyPeers) {
+		return models.FailureTooManyPeers
+	}
+	return models.FailureUnknown
+}
+
+// connectError classifies a connection error. The connection upgrader formats
+// its errors, so the step is found from the error message
+func connectError(err error) error {
+	msg := err.Error()
+	switch {
+	case strings.Contains(msg, "failed to negotiate security protocol"):
+		return newProbeError(models.FailureSecurityHandshake, err)
+	case strings.Contains(msg, "failed to negotiate stream multiplexer"):
+		return newProbeError(models.FailureMultistreamNegotiation, err)
+	default:
+		return newProbeError(models.FailureDial, err)
+	}
+}
+
+// requestError classifies the error of a request, reason is used unless
+// the peer doesn't support the protocol of the request
+func requestError(reason models.FailureReason, err error) error {
+	if strings.Contains(err.Error(), "protocol not supported") {
+		return newProbeError(models.FailureMultistreamNegotiation, err)
+	}
+	return newProbeError(reason, err)
+}
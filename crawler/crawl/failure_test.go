@@ -0,0 +1,33 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package crawl
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"eth2-crawler/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFailureReason(t *testing.T) {
+	tests := []struct {
+		err    error
+		reason models.FailureReason
+	}{
+		{connectError(errors.New("failed to dial: all dials failed: dial tcp 1.2.3.4:9000: i/o timeout")), models.FailureDial},
+		{connectError(errors.New("failed to dial: all dials failed: failed to negotiate security protocol: EOF")), models.FailureSecurityHandshake},
+		{connectError(errors.New("failed to dial: all dials failed: failed to negotiate stream multiplexer: EOF")), models.FailureMultistreamNegotiation},
+		{requestError(models.FailureStatusStreamReset, errors.New("stream reset")), models.FailureStatusStreamReset},
+		{requestError(models.FailureStatusStreamReset, errors.New("protocol not supported")), models.FailureMultistreamNegotiation},
+		{fmt.Errorf("attempt: %w", newProbeError(models.FailureIdentifyTimeout, errors.New("item not found"))), models.FailureIdentifyTimeout},
+		{errTooManyPeers, models.FailureTooManyPeers},
+		{errors.New("unexpected"), models.FailureUnknown},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.reason, failureReason(tt.err), tt.err.Error())
+	}
+}
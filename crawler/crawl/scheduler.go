@@ -0,0 +1,87 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package crawl
+
+import (
+	"math/rand"
+	"time"
+
+	"eth2-crawler/models"
+	"eth2-crawler/utils/config"
+)
+
+// up to 1/jitterDivisor of a probe delay is added as jitter,
+// so the peers found together are not probed together forever
+const jitterDivisor = 5
+
+// scheduler sets the next probe time of the peers from their probe state
+type scheduler struct {
+	refreshInterval time.Duration
+	backoffBase     time.Duration
+	backoffMax      time.Duration
+	deadAfter       int
+}
+
+func newScheduler(cfg *config.Crawler) *scheduler {
+	return &scheduler{
+		refreshInterval: time.Duration(cfg.RefreshInterval) * time.Second,
+		backoffBase:     time.Duration(cfg.BackoffBase) * time.Second,
+		backoffMax:      time.Duration(cfg.BackoffMax) * time.Second,
+		deadAfter:       cfg.DeadAfter,
+	}
+}
+
+// success marks the peer healthy, it is probed again after the refresh interval
+func (s *scheduler) success(peer *models.Peer) {
+	peer.ProbeState = models.ProbeStateHealthy
+	peer.FailedAttempts = 0
+	peer.BusyAttempts = 0
+	peer.Score = models.ScoreGood
+	s.setNextProbe(peer, s.refreshInterval)
+}
+
+// failure counts a failed probe and backs off exponentially.
+// Dead peers are probed at the max delay and lose score on every failure
+func (s *scheduler) failure(peer *models.Peer) {
+	peer.FailedAttempts++
+	peer.BusyAttempts = 0
+	switch {
+	case peer.FailedAttempts >= s.deadAfter:
+		if peer.ProbeState == models.ProbeStateDead {
+			peer.Score--
+		}
+		peer.ProbeState = models.ProbeStateDead
+		s.setNextProbe(peer, s.backoffMax)
+		return
+	case peer.ProbeState == models.ProbeStateHealthy:
+		peer.ProbeState = models.ProbeStateFlaky
+	}
+	s.setNextProbe(peer, s.backoff(peer.FailedAttempts))
+}
+
+// busy pushes back the probe of a peer refusing the crawler for having too many peers,
+// exponentially while it keeps refusing. The peer is reachable so its state is kept
+func (s *scheduler) busy(peer *models.Peer) {
+	peer.BusyAttempts++
+	s.setNextProbe(peer, s.backoff(peer.BusyAttempts))
+}
+
+// backoff returns the delay after the given number of consecutive failures
+func (s *scheduler) backoff(failures int) time.Duration {
+	delay := s.backoffBase
+	for i := 1; i < failures && delay < s.backoffMax; i++ {
+		delay *= 2
+	}
+	if delay > s.backoffMax {
+		delay = s.backoffMax
+	}
+	return delay
+}
+
+func (s *scheduler) setNextProbe(peer *models.Peer, delay time.Duration) {
+	if jitter := int64(delay) / jitterDivisor; jitter > 0 {
+		delay += time.Duration(rand.Int63n(jitter))
+	}
+	peer.NextProbeAt = time.Now().Add(delay).Unix()
+}
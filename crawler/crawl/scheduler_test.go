@@ -0,0 +1,81 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package crawl
+
+import (
+	"testing"
+	"time"
+
+	"eth2-crawler/models"
+	"eth2-crawler/utils/config"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testScheduler() *scheduler {
+	return newScheduler(&config.Crawler{
+		RefreshInterval: 86400,
+		BackoffBase:     60,
+		BackoffMax:      3600,
+		DeadAfter:       3,
+	})
+}
+
+func TestSchedulerBackoff(t *testing.T) {
+	s := testScheduler()
+	assert.Equal(t, time.Minute, s.backoff(1))
+	assert.Equal(t, 2*time.Minute, s.backoff(2))
+	assert.Equal(t, 32*time.Minute, s.backoff(6))
+	assert.Equal(t, time.Hour, s.backoff(7))
+	assert.Equal(t, time.Hour, s.backoff(100))
+}
+
+func TestSchedulerStates(t *testing.T) {
+	s := testScheduler()
+	peer := &models.Peer{ProbeState: models.ProbeStateNew, Score: models.ScoreGood}
+
+	s.failure(peer)
+	assert.Equal(t, models.ProbeStateNew, peer.ProbeState)
+	assertNextProbe(t, peer, time.Minute)
+
+	s.success(peer)
+	assert.Equal(t, models.ProbeStateHealthy, peer.ProbeState)
+	assert.Equal(t, 0, peer.FailedAttempts)
+	assertNextProbe(t, peer, 24*time.Hour)
+
+	s.failure(peer)
+	s.failure(peer)
+	assert.Equal(t, models.ProbeStateFlaky, peer.ProbeState)
+	assertNextProbe(t, peer, 2*time.Minute)
+
+	s.failure(peer)
+	assert.Equal(t, models.ProbeStateDead, peer.ProbeState)
+	assert.Equal(t, models.ScoreGood, peer.Score)
+	assertNextProbe(t, peer, time.Hour)
+
+	s.failure(peer)
+	assert.Equal(t, models.ScoreGood-1, peer.Score)
+
+	s.busy(peer)
+	assert.Equal(t, models.ProbeStateDead, peer.ProbeState)
+	assertNextProbe(t, peer, time.Minute)
+
+	s.busy(peer)
+	assertNextProbe(t, peer, 2*time.Minute)
+	for i := 0; i < 10; i++ {
+		s.busy(peer)
+	}
+	assertNextProbe(t, peer, time.Hour)
+
+	s.success(peer)
+	s.busy(peer)
+	assertNextProbe(t, peer, time.Minute)
+}
+
+// assertNextProbe checks the next probe is set after delay, with at most a fifth of it as jitter
+func assertNextProbe(t *testing.T, peer *models.Peer, delay time.Duration) {
+	now := time.Now()
+	assert.GreaterOrEqual(t, peer.NextProbeAt, now.Add(delay).Unix()-1)
+	assert.LessOrEqual(t, peer.NextProbeAt, now.Add(delay+delay/jitterDivisor).Unix())
+}
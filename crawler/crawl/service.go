@@ -7,10 +7,17 @@ package crawl
 import (
 	"context"
 	"crypto/ecdsa"
+	"errors"
+	"eth2-crawler/models"
+	"eth2-crawler/store/event"
 	"eth2-crawler/store/peerstore"
 	"eth2-crawler/store/record"
+	"eth2-crawler/utils/config"
 	"fmt"
 	"net"
+	"os"
+	"path/filepath"
+	"time"
 
 	"github.com/robfig/cron/v3"
 
@@ -19,54 +26,89 @@ import (
 
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/libp2p/go-libp2p"
-	ic "github.com/libp2p/go-libp2p-core/crypto"
-	noise "github.com/libp2p/go-libp2p-noise"
-	"github.com/libp2p/go-tcp-transport"
+	ic "github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/p2p/net/connmgr"
+	quic "github.com/libp2p/go-libp2p/p2p/transport/quic"
+	"github.com/libp2p/go-libp2p/p2p/transport/tcp"
 	ma "github.com/multiformats/go-multiaddr"
 )
 
+// connGracePeriod is the time new connections are kept open by the connection manager
+const connGracePeriod = time.Minute
+
 // listenConfig holds configuration for running v5discovry node
 type listenConfig struct {
-	bootNodeAddrs []string
-	listenAddress net.IP
-	listenPORT    int
-	dbPath        string
-	privateKey    *ecdsa.PrivateKey
+	bootNodeAddrs  []string
+	listenAddress  net.IP
+	listenAddress6 net.IP // nil when ipv6 is disabled
+	listenPORT     int
+	dbPath         string
+	privateKey     *ecdsa.PrivateKey
 }
 
-// Initialize initializes the core crawler component
-func Initialize(peerStore peerstore.Provider, historyStore record.Provider, ipResolver ipResolver.Provider, bootNodeAddrs []string) error {
+// Initialize initializes the core crawler component.
+// A single discovery node is started with the bootnodes of all the networks,
+// the discovered nodes are then matched against each network fork schedule.
+// It returns the connection metrics of the crawler host
+func Initialize(cfg *config.Crawler, peerStore peerstore.Provider, historyStore record.Provider, eventStore event.Provider,
+	ipResolver ipResolver.Provider, networks models.Networks, uaParser *models.UserAgentParser) (p2p.Metrics, error) {
 	ctx := context.Background()
-	pkey, _ := crypto.GenerateKey()
+	pkey, err := loadPrivateKey(cfg.KeyPath)
+	if err != nil {
+		return nil, err
+	}
 	listenCfg := &listenConfig{
-		bootNodeAddrs: bootNodeAddrs,
-		listenAddress: net.IPv4zero,
-		listenPORT:    30304,
-		dbPath:        "",
-		privateKey:    pkey,
+		bootNodeAddrs:  networks.Bootnodes(),
+		listenAddress:  net.ParseIP(cfg.ListenAddress),
+		listenAddress6: net.ParseIP(cfg.ListenAddress6),
+		listenPORT:     cfg.ListenPort,
+		dbPath:         cfg.NodeDBPath,
+		privateKey:     pkey,
 	}
-	disc, err := startV5(listenCfg)
+	disc, db, err := startV5(listenCfg)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	if listenCfg.dbPath != "" {
+		// keep the discovered nodes to bootstrap from them after a restart
+		go persistNodes(ctx, disc, db)
 	}
 
-	listenAddrs, err := multiAddressBuilder(listenCfg.listenAddress, listenCfg.listenPORT)
+	listenAddrs := make([]ma.Multiaddr, 0, 2)
+	for _, ip := range []net.IP{listenCfg.listenAddress, listenCfg.listenAddress6} {
+		if ip == nil {
+			continue
+		}
+		addr, err := multiAddressBuilder(ip, listenCfg.listenPORT)
+		if err != nil {
+			return nil, err
+		}
+		listenAddrs = append(listenAddrs, addr)
+	}
+	// probe connections are closed after each probe, the manager bounds the inbound ones
+	connManager, err := connmgr.NewConnManager(cfg.MaxConnections*3/4, cfg.MaxConnections, connmgr.WithGracePeriod(connGracePeriod))
+	if err != nil {
+		return nil, err
+	}
+	identity, err := convertToInterfacePrivkey(listenCfg.privateKey)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	host, err := p2p.NewHost(
-		libp2p.Identity(convertToInterfacePrivkey(listenCfg.privateKey)),
-		libp2p.ListenAddrs(listenAddrs),
+	host, err := p2p.NewHost(cfg.Security, cfg.Muxers, networks,
+		libp2p.Identity(identity),
+		libp2p.ListenAddrs(listenAddrs...),
 		libp2p.UserAgent("Eth2-Crawler"),
+		// quic is only dialed, its udp port would clash with the discovery one
 		libp2p.Transport(tcp.NewTCPTransport),
-		libp2p.Security(noise.ID, noise.New),
+		libp2p.Transport(quic.NewTransport),
 		libp2p.NATPortMap(),
+		libp2p.ConnectionManager(connManager),
 	)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	c := newCrawler(disc, peerStore, historyStore, ipResolver, listenCfg.privateKey, disc.RandomNodes(), host, 200)
+	c := newCrawler(cfg, disc, peerStore, historyStore, eventStore, ipResolver, listenCfg.privateKey, disc.RandomNodes(), host, networks, uaParser)
 	go c.start(ctx)
 	// scheduler for updating peer
 	go c.updatePeer(ctx)
@@ -75,15 +117,40 @@ func Initialize(peerStore peerstore.Provider, historyStore record.Provider, ipRe
 	scheduler := cron.New()
 	_, err = scheduler.AddFunc("@daily", c.insertToHistory)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	scheduler.Start()
-	return nil
+	return host, nil
+}
+
+// loadPrivateKey loads the crawler node key from path. A new key is generated and saved
+// when the file doesn't exist yet, and an ephemeral one is used when no path is given
+func loadPrivateKey(path string) (*ecdsa.PrivateKey, error) {
+	if path == "" {
+		return crypto.GenerateKey()
+	}
+	key, err := crypto.LoadECDSA(path)
+	if err == nil {
+		return key, nil
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("error loading node key: %w", err)
+	}
+	key, err = crypto.GenerateKey()
+	if err != nil {
+		return nil, fmt.Errorf("error generating node key: %w", err)
+	}
+	if err = os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, fmt.Errorf("error creating node key directory: %w", err)
+	}
+	if err = crypto.SaveECDSA(path, key); err != nil {
+		return nil, fmt.Errorf("error saving node key: %w", err)
+	}
+	return key, nil
 }
 
-func convertToInterfacePrivkey(privkey *ecdsa.PrivateKey) ic.PrivKey {
-	typeAssertedKey := ic.PrivKey((*ic.Secp256k1PrivateKey)(privkey))
-	return typeAssertedKey
+func convertToInterfacePrivkey(privkey *ecdsa.PrivateKey) (ic.PrivKey, error) {
+	return ic.UnmarshalSecp256k1PrivateKey(crypto.FromECDSA(privkey))
 }
 
 func multiAddressBuilder(ipAddr net.IP, port int) (ma.Multiaddr, error) {
@@ -4,34 +4,56 @@
 package crawl
 
 import (
-	"bytes"
-	"encoding/base64"
-	"encoding/hex"
+	"context"
+	"eth2-crawler/crawler/util"
 	"fmt"
 	"net"
-	"strings"
+	"time"
 
 	"github.com/ethereum/go-ethereum/p2p/discover"
 	"github.com/ethereum/go-ethereum/p2p/enode"
-	"github.com/ethereum/go-ethereum/p2p/enr"
-	"github.com/ethereum/go-ethereum/rlp"
 )
 
-// startV5 starts an ephemeral discovery v5 node.
-func startV5(listenCfg *listenConfig) (*discover.UDPv5, error) {
+// persistNodesInterval is the interval for saving the discovery table to the node database
+const persistNodesInterval = 30 * time.Second
+
+// startV5 starts a discovery v5 node. The node database is in memory if no db path is given.
+func startV5(listenCfg *listenConfig) (*discover.UDPv5, *enode.DB, error) {
 	ln, config, err := getDiscoveryConfig(listenCfg)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	socket, err := listen(listenCfg)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	disc, err := discover.ListenV5(socket, ln, *config)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+	return disc, ln.Database(), nil
+}
+
+// persistNodes periodically saves the nodes of the discovery table to the node database.
+// The table loads its seed nodes from the database on start, but only the ones having a recent pong,
+// which discovery v5 doesn't record. Nodes still in the table passed the revalidation, so they are stored as live.
+func persistNodes(ctx context.Context, disc *discover.UDPv5, db *enode.DB) {
+	ticker := time.NewTicker(persistNodesInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			now := time.Now()
+			for _, n := range disc.AllNodes() {
+				if err := db.UpdateNode(n); err != nil {
+					continue
+				}
+				_ = db.UpdateLastPongReceived(n.ID(), n.IP(), now)
+			}
+		}
 	}
-	return disc, nil
 }
 
 // getDiscoveryConfig returns config for listening v5 node for peer discovery
@@ -53,10 +75,16 @@ func getDiscoveryConfig(listenCfg *listenConfig) (*enode.LocalNode, *discover.Co
 	return ln, cfg, nil
 }
 
-// listen opens an udp connections on given address
+// listen opens an udp connections on given address.
+// Discovery runs on a single socket, a dual-stack one is opened when both addresses are unspecified,
+// a specific ipv6 address takes precedence over the ipv4 one
 func listen(cfg *listenConfig) (*net.UDPConn, error) {
+	ip := cfg.listenAddress
+	if cfg.listenAddress6 != nil && (!cfg.listenAddress6.IsUnspecified() || ip.IsUnspecified()) {
+		ip = cfg.listenAddress6
+	}
 	udpAddr := &net.UDPAddr{
-		IP:   cfg.listenAddress,
+		IP:   ip,
 		Port: cfg.listenPORT,
 	}
 	conn, err := net.ListenUDP("udp", udpAddr)
@@ -71,53 +99,10 @@ func parseBootNodes(nodeStr []string) ([]*enode.Node, error) {
 	nodes := make([]*enode.Node, len(nodeStr))
 	var err error
 	for i, record := range nodeStr {
-		nodes[i], err = parseNode(record)
+		nodes[i], err = util.ParseNode(record)
 		if err != nil {
 			return nil, fmt.Errorf("invalid bootstrap node: %w", err)
 		}
 	}
 	return nodes, nil
 }
-
-// parseNode parses a node record and verifies its signature.
-func parseNode(source string) (*enode.Node, error) {
-	if strings.HasPrefix(source, "enode://") {
-		return enode.ParseV4(source)
-	}
-	r, err := parseRecord(source)
-	if err != nil {
-		return nil, err
-	}
-	return enode.New(enode.ValidSchemes, r)
-}
-
-// parseRecord parses a node record from hex, base64, or raw binary input.
-func parseRecord(source string) (*enr.Record, error) {
-	bin := []byte(source)
-	if d, ok := decodeRecordHex(bytes.TrimSpace(bin)); ok {
-		bin = d
-	} else if d, ok := decodeRecordBase64(bytes.TrimSpace(bin)); ok {
-		bin = d
-	}
-	var r enr.Record
-	err := rlp.DecodeBytes(bin, &r)
-	return &r, err
-}
-
-func decodeRecordHex(b []byte) ([]byte, bool) {
-	if bytes.HasPrefix(b, []byte("0x")) {
-		b = b[2:]
-	}
-	dec := make([]byte, hex.DecodedLen(len(b)))
-	_, err := hex.Decode(dec, b)
-	return dec, err == nil
-}
-
-func decodeRecordBase64(b []byte) ([]byte, bool) {
-	if bytes.HasPrefix(b, []byte("enr:")) {
-		b = b[4:]
-	}
-	dec := make([]byte, base64.RawURLEncoding.DecodedLen(len(b)))
-	n, err := base64.RawURLEncoding.Decode(dec, b)
-	return dec[:n], err == nil
-}
@@ -0,0 +1,94 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package p2p
+
+import (
+	"context"
+	"eth2-crawler/crawler/rpc/methods"
+	reqresp "eth2-crawler/crawler/rpc/request"
+	"eth2-crawler/models"
+	"sync/atomic"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/protocol"
+	ma "github.com/multiformats/go-multiaddr"
+	beacon "github.com/protolambda/zrnt/eth2/beacon/common"
+)
+
+// goodbyeTimeout is the time limit of sending a goodbye
+const goodbyeTimeout = 2 * time.Second
+
+// ConnectionMetrics holds the connection counters of the host
+type ConnectionMetrics struct {
+	Open     int    `json:"open"`
+	Inbound  int    `json:"inbound"`
+	Outbound int    `json:"outbound"`
+	Opened   uint64 `json:"opened"` // connections opened since the start
+	Closed   uint64 `json:"closed"` // connections closed since the start
+}
+
+// Metrics provides the connection metrics of the host
+type Metrics interface {
+	ConnectionMetrics() *ConnectionMetrics
+}
+
+// connCounter counts the opened and closed connections of the host
+type connCounter struct {
+	opened uint64
+	closed uint64
+}
+
+func (cc *connCounter) Listen(network.Network, ma.Multiaddr)         {}
+func (cc *connCounter) ListenClose(network.Network, ma.Multiaddr)    {}
+func (cc *connCounter) OpenedStream(network.Network, network.Stream) {}
+func (cc *connCounter) ClosedStream(network.Network, network.Stream) {}
+
+func (cc *connCounter) Connected(network.Network, network.Conn) {
+	atomic.AddUint64(&cc.opened, 1)
+}
+
+func (cc *connCounter) Disconnected(network.Network, network.Conn) {
+	atomic.AddUint64(&cc.closed, 1)
+}
+
+// ConnectionMetrics returns the open connections and the connection counters
+func (c *Client) ConnectionMetrics() *ConnectionMetrics {
+	metrics := &ConnectionMetrics{
+		Opened: atomic.LoadUint64(&c.conns.opened),
+		Closed: atomic.LoadUint64(&c.conns.closed),
+	}
+	for _, conn := range c.Network().Conns() {
+		metrics.Open++
+		if conn.Stat().Direction == network.DirInbound {
+			metrics.Inbound++
+		} else {
+			metrics.Outbound++
+		}
+	}
+	return metrics
+}
+
+// Disconnect sends a goodbye with the reason to a connected peer and closes the connections to it
+func (c *Client) Disconnect(ctx context.Context, peerID peer.ID, reason models.GoodbyeReason) error {
+	if c.Network().Connectedness(peerID) == network.Connected {
+		ctx, cancel := context.WithTimeout(ctx, goodbyeTimeout)
+		defer cancel()
+		newStream := func(ctx context.Context, peerID peer.ID, protocols ...protocol.ID) (network.Stream, error) {
+			stream, err := c.NewStream(ctx, peerID, protocols...)
+			if err == nil {
+				_ = stream.SetDeadline(time.Now().Add(goodbyeTimeout))
+			}
+			return stream, err
+		}
+		// the goodbye is sent on a best effort basis. A response chunk is awaited, the peer doesn't
+		// send any but closes the stream once it read the goodbye, before the connection is closed
+		_ = methods.GoodbyeRPCv1.RunRequest(ctx, newStream, peerID, new(reqresp.SnappyCompression),
+			reqresp.RequestSSZInput{Obj: beacon.Goodbye(reason)}, 1,
+			func() error { return nil },
+			func(chunk reqresp.ChunkedResponseHandler) error { return nil })
+	}
+	return c.Network().ClosePeer(peerID)
+}
@@ -0,0 +1,98 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package p2p
+
+import (
+	"context"
+	"eth2-crawler/crawler/rpc/methods"
+	reqresp "eth2-crawler/crawler/rpc/request"
+	"eth2-crawler/models"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/protocol"
+	beacon "github.com/protolambda/zrnt/eth2/beacon/common"
+	"github.com/protolambda/ztyp/codec"
+)
+
+// registerHandlers serves the eth2 requests of the peers, so they don't drop the crawler
+// for not answering the handshake
+func (c *Client) registerHandlers() {
+	comp := new(reqresp.SnappyCompression)
+	c.setHandler(&methods.StatusRPCv1, comp, c.handleStatus)
+	c.setHandler(&methods.PingRPCv1, comp, c.handlePing)
+	c.setHandler(&methods.MetaDataRPCv2, comp, c.handleMetaDataV2)
+	c.setHandler(&methods.MetaDataRPCv3, comp, c.handleMetaDataV3)
+	c.setHandler(&methods.GoodbyeRPCv1, comp, c.handleGoodbye)
+}
+
+func (c *Client) setHandler(method *reqresp.RPCMethod, comp reqresp.Compression, listener reqresp.OnRequestListener) {
+	protocolID := method.Protocol + protocol.ID("_"+comp.Name())
+	c.SetStreamHandler(protocolID, method.MakeStreamHandler(context.Background, comp, listener))
+}
+
+// handleStatus answers with the fork digest and finalized checkpoint of the requester, with
+// the checkpoint as head too. The crawler has no chain, it is seen behind so no block is requested
+func (c *Client) handleStatus(ctx context.Context, peerID peer.ID, handler reqresp.ChunkedRequestHandler) {
+	var status beacon.Status
+	if err := handler.ReadRequest(&status); err != nil {
+		writeInvalidRequest(peerID, handler, err)
+		return
+	}
+	network := c.networks.Match(&beacon.Eth2Data{ForkDigest: status.ForkDigest})
+	if network == nil {
+		writeInvalidRequest(peerID, handler, fmt.Errorf("unknown fork digest %s", status.ForkDigest))
+		return
+	}
+	status.HeadRoot = status.FinalizedRoot
+	status.HeadSlot = beacon.Slot(uint64(status.FinalizedEpoch) * network.SlotsPerEpoch)
+	writeResponse(peerID, handler, &status)
+}
+
+func (c *Client) handlePing(ctx context.Context, peerID peer.ID, handler reqresp.ChunkedRequestHandler) {
+	var ping beacon.Ping
+	if err := handler.ReadRequest(&ping); err != nil {
+		writeInvalidRequest(peerID, handler, err)
+		return
+	}
+	writeResponse(peerID, handler, beacon.Pong(localSeqNumber))
+}
+
+// handleMetaDataV2 answers with empty metadata, the crawler is not subscribed to any subnet
+func (c *Client) handleMetaDataV2(ctx context.Context, peerID peer.ID, handler reqresp.ChunkedRequestHandler) {
+	writeResponse(peerID, handler, &methods.MetaDataV2{SeqNumber: localSeqNumber})
+}
+
+// handleMetaDataV3 answers with empty metadata, the crawler custodies no data column
+func (c *Client) handleMetaDataV3(ctx context.Context, peerID peer.ID, handler reqresp.ChunkedRequestHandler) {
+	writeResponse(peerID, handler, &methods.MetaDataV3{SeqNumber: localSeqNumber})
+}
+
+// handleGoodbye stores the disconnection reason in the peerstore, goodbye messages are not answered
+func (c *Client) handleGoodbye(ctx context.Context, peerID peer.ID, handler reqresp.ChunkedRequestHandler) {
+	var reason beacon.Goodbye
+	if err := handler.ReadRequest(&reason); err != nil {
+		log.Debug("invalid goodbye request", log.Ctx{"err": err, "peer_id": peerID})
+		return
+	}
+	goodbye := models.NewGoodbye(models.GoodbyeReason(reason))
+	log.Debug("received goodbye", log.Ctx{"reason": goodbye.Reason.String(), "peer_id": peerID})
+	if err := c.Peerstore().Put(peerID, goodbyeKey, goodbye); err != nil {
+		log.Error("unable to store goodbye", log.Ctx{"err": err, "peer_id": peerID})
+	}
+}
+
+func writeResponse(peerID peer.ID, handler reqresp.ChunkedRequestHandler, data codec.Serializable) {
+	if err := handler.WriteResponseChunk(reqresp.SuccessCode, data); err != nil {
+		log.Debug("unable to write response", log.Ctx{"err": err, "peer_id": peerID})
+	}
+}
+
+func writeInvalidRequest(peerID peer.ID, handler reqresp.ChunkedRequestHandler, err error) {
+	log.Debug("invalid request", log.Ctx{"err": err, "peer_id": peerID})
+	if err := handler.WriteErrorChunk(reqresp.InvalidReqCode, err.Error()); err != nil {
+		log.Debug("unable to write error response", log.Ctx{"err": err, "peer_id": peerID})
+	}
+}
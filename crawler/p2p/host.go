@@ -9,101 +9,160 @@ import (
 	"errors"
 	"eth2-crawler/crawler/rpc/methods"
 	reqresp "eth2-crawler/crawler/rpc/request"
+	"eth2-crawler/crawler/util"
 	"eth2-crawler/models"
 	"fmt"
+	"slices"
+	"time"
 
 	beacon "github.com/protolambda/zrnt/eth2/beacon/common"
+	"github.com/protolambda/ztyp/codec"
 
 	"github.com/libp2p/go-libp2p"
-	"github.com/libp2p/go-libp2p-core/host"
-	"github.com/libp2p/go-libp2p-core/network"
-	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p/core/event"
+	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/libp2p/go-libp2p/p2p/protocol/identify"
+	"github.com/libp2p/go-libp2p/p2p/protocol/ping"
 )
 
+// localSeqNumber is the sequence number of the crawler metadata, which never changes
+const localSeqNumber = 0
+
+// pingTimeout is the time limit of a round trip time measure
+const pingTimeout = 10 * time.Second
+
+// goodbyeKey is the peerstore key of the last goodbye received from a peer
+const goodbyeKey = "Goodbye"
+
 // Client represent custom p2p client
 type Client struct {
 	host.Host
-	idSvc idService
+	idSvc    idService
+	networks models.Networks // the status requests are answered for the peers of these networks
+	conns    *connCounter
+	sub      event.Subscription // identify events
+	observed *observedAddrs
 }
 
 // Host represent p2p services
 type Host interface {
 	host.Host
-	IdentifyRequest(ctx context.Context, peerInfo *peer.AddrInfo) error
-	GetProtocolVersion(peer.ID) (string, error)
-	GetAgentVersion(peer.ID) (string, error)
+	Metrics
+	Disconnect(ctx context.Context, peerID peer.ID, reason models.GoodbyeReason) error
+	FetchIdentify(ctx context.Context, peerID peer.ID) (*models.Identify, error)
+	GetGoodbye(peer.ID) (*models.Goodbye, error)
+	GetTransports(peer.ID) []string
+	GetSecurity(peer.ID) (string, error)
+	GetMuxer(peer.ID) (string, error)
 	FetchStatus(sFn reqresp.NewStreamFn, ctx context.Context, peer *models.Peer, comp reqresp.Compression) (
 		*beacon.Status, error)
+	FetchMetaData(sFn reqresp.NewStreamFn, ctx context.Context, peer *models.Peer, comp reqresp.Compression) (
+		*models.MetaData, error)
+	MeasureRTT(ctx context.Context, peerID peer.ID) (time.Duration, error)
+	Ping(sFn reqresp.NewStreamFn, ctx context.Context, peer *models.Peer, comp reqresp.Compression) (uint64, error)
 }
 
 type idService interface {
 	IdentifyWait(c network.Conn) <-chan struct{}
 }
 
-// NewHost initializes custom host
-func NewHost(opt ...libp2p.Option) (Host, error) {
-	h, err := libp2p.New(context.Background(), opt...)
+// NewHost initializes custom host with the named security transports and stream muxers, in order of preference,
+// serving the peers of the networks
+func NewHost(security, muxers []string, networks models.Networks, opt ...libp2p.Option) (Host, error) {
+	securityOpts, err := securityOptions(security)
 	if err != nil {
 		return nil, err
 	}
-	idService, err := identify.NewIDService(h)
+	muxerOpts, err := muxerOptions(muxers)
 	if err != nil {
 		return nil, err
 	}
-	return &Client{Host: h, idSvc: idService}, nil
-}
-
-// IdentifyRequest performs libp2p identify request after connecting to peer.
-// It disconnects to peer after request is done
-func (c *Client) IdentifyRequest(ctx context.Context, peerInfo *peer.AddrInfo) error {
-	// Connect to peer first
-	err := c.Connect(ctx, *peerInfo)
+	opt = append(opt, securityOpts...)
+	opt = append(opt, muxerOpts...)
+	h, err := libp2p.New(opt...)
 	if err != nil {
-		return fmt.Errorf("error connecting to peer: %w", err)
-	}
-	defer func() {
-		_ = c.Network().ClosePeer(peerInfo.ID)
-	}()
-	if conns := c.Network().ConnsToPeer(peerInfo.ID); len(conns) > 0 {
-		select {
-		case <-c.idSvc.IdentifyWait(conns[0]):
-		case <-ctx.Done():
-		}
-	} else {
-		return errors.New("not connected to peer, cannot await connection identify")
+		return nil, err
 	}
-	return nil
+	// the host runs the identify service, its requests are awaited instead of started again
+	ids, ok := h.(interface{ IDService() identify.IDService })
+	if !ok {
+		_ = h.Close()
+		return nil, errors.New("host without identify service")
+	}
+	sub, err := h.EventBus().Subscribe([]interface{}{
+		new(event.EvtPeerIdentificationCompleted),
+		new(event.EvtPeerIdentificationFailed),
+	})
+	if err != nil {
+		_ = h.Close()
+		return nil, err
+	}
+	c := &Client{
+		Host:     h,
+		idSvc:    ids.IDService(),
+		networks: networks,
+		conns:    new(connCounter),
+		sub:      sub,
+		observed: newObservedAddrs(),
+	}
+	go c.observed.record(sub)
+	h.Network().Notify(c.conns)
+	h.Network().Notify(c.observed)
+	c.registerHandlers()
+	return c, nil
+}
+
+// Close stops the identify event subscription and the host
+func (c *Client) Close() error {
+	_ = c.sub.Close()
+	return c.Host.Close()
 }
 
-// GetProtocolVersion returns peer protocol version from peerstore.
-// Need to call IdentifyRequest first for a peer.
-func (c *Client) GetProtocolVersion(peerID peer.ID) (string, error) {
-	key := "ProtocolVersion"
-	value, err := c.Peerstore().Get(peerID, key)
+// GetGoodbye returns the last goodbye received from the peer from peerstore.
+func (c *Client) GetGoodbye(peerID peer.ID) (*models.Goodbye, error) {
+	value, err := c.Peerstore().Get(peerID, goodbyeKey)
 	if err != nil {
-		return "", fmt.Errorf("error getting protocol version:%w", err)
+		return nil, fmt.Errorf("error getting goodbye:%w", err)
 	}
-	version, ok := value.(string)
+	goodbye, ok := value.(*models.Goodbye)
 	if !ok {
-		return "", fmt.Errorf("error converting interface to string")
+		return nil, fmt.Errorf("error converting interface to goodbye")
 	}
-	return version, nil
+	return goodbye, nil
 }
 
-// GetAgentVersion returns peer agent version  from peerstore.
-// Need to call IdentifyRequest first for a peer.
-func (c *Client) GetAgentVersion(peerID peer.ID) (string, error) {
-	key := "AgentVersion"
-	value, err := c.Peerstore().Get(peerID, key)
-	if err != nil {
-		return "", fmt.Errorf("error getting protocol version:%w", err)
+// GetTransports returns the transports of the open connections to the peer
+func (c *Client) GetTransports(peerID peer.ID) []string {
+	transports := make([]string, 0)
+	for _, conn := range c.Network().ConnsToPeer(peerID) {
+		transport := util.MultiAddrTransport(conn.RemoteMultiaddr())
+		if !slices.Contains(transports, transport) {
+			transports = append(transports, transport)
+		}
 	}
-	version, ok := value.(string)
-	if !ok {
-		return "", fmt.Errorf("error converting interface to string")
+	return transports
+}
+
+// GetSecurity returns the security transport negotiated with the peer
+func (c *Client) GetSecurity(peerID peer.ID) (string, error) {
+	security, _, err := c.getNegotiated(peerID)
+	return security, err
+}
+
+// GetMuxer returns the stream muxer negotiated with the peer
+func (c *Client) GetMuxer(peerID peer.ID) (string, error) {
+	_, muxer, err := c.getNegotiated(peerID)
+	return muxer, err
+}
+
+func (c *Client) getNegotiated(peerID peer.ID) (security, muxer string, err error) {
+	conns := c.Network().ConnsToPeer(peerID)
+	if len(conns) == 0 {
+		return "", "", errors.New("not connected to peer")
 	}
-	return version, nil
+	return negotiated(conns[0])
 }
 
 func (c *Client) FetchStatus(sFn reqresp.NewStreamFn, ctx context.Context, peer *models.Peer, comp reqresp.Compression) (
@@ -116,31 +175,100 @@ func (c *Client) FetchStatus(sFn reqresp.NewStreamFn, ctx context.Context, peer
 		HeadRoot:       beacon.Root{},
 		HeadSlot:       0,
 	}
-	resCode := reqresp.ServerErrCode // error by default
-	var data *beacon.Status
-	err := methods.StatusRPCv1.RunRequest(ctx, sFn, peer.ID, comp,
-		reqresp.RequestSSZInput{Obj: status}, 1,
+	var data beacon.Status
+	err := runSingleChunkRequest(ctx, sFn, &methods.StatusRPCv1, peer, comp, reqresp.RequestSSZInput{Obj: status}, &data)
+	if err != nil {
+		return nil, err
+	}
+	return &data, nil
+}
+
+// FetchMetaData requests the peer metadata. The fulu version is tried first,
+// peers not supporting it yet are requested the altair version
+func (c *Client) FetchMetaData(sFn reqresp.NewStreamFn, ctx context.Context, peer *models.Peer, comp reqresp.Compression) (
+	*models.MetaData, error) {
+	var v3 methods.MetaDataV3
+	err := runSingleChunkRequest(ctx, sFn, &methods.MetaDataRPCv3, peer, comp, reqresp.RequestBytesInput{}, &v3)
+	if err == nil {
+		return &models.MetaData{
+			Version:           3,
+			SeqNumber:         uint64(v3.SeqNumber),
+			Attnets:           v3.Attnets,
+			Syncnets:          v3.Syncnets,
+			CustodyGroupCount: uint64(v3.CustodyGroupCount),
+		}, nil
+	}
+	var v2 methods.MetaDataV2
+	err = runSingleChunkRequest(ctx, sFn, &methods.MetaDataRPCv2, peer, comp, reqresp.RequestBytesInput{}, &v2)
+	if err != nil {
+		return nil, err
+	}
+	return &models.MetaData{
+		Version:   2,
+		SeqNumber: uint64(v2.SeqNumber),
+		Attnets:   v2.Attnets,
+		Syncnets:  v2.Syncnets,
+	}, nil
+}
+
+// MeasureRTT measures the round trip time to a connected peer with the libp2p ping
+func (c *Client) MeasureRTT(ctx context.Context, peerID peer.ID) (time.Duration, error) {
+	ctx, cancel := context.WithTimeout(ctx, pingTimeout)
+	defer cancel()
+	// the results stop when the context is done, the first one is enough
+	res, ok := <-ping.Ping(ctx, c, peerID)
+	if !ok {
+		return 0, fmt.Errorf("error pinging peer: %w", ctx.Err())
+	}
+	if res.Error != nil {
+		return 0, fmt.Errorf("error pinging peer: %w", res.Error)
+	}
+	return res.RTT, nil
+}
+
+// Ping sends the eth2 ping to the peer and returns its metadata sequence number
+func (c *Client) Ping(sFn reqresp.NewStreamFn, ctx context.Context, peer *models.Peer, comp reqresp.Compression) (uint64, error) {
+	ping := beacon.Ping(localSeqNumber)
+	var pong beacon.Pong
+	err := runSingleChunkRequest(ctx, sFn, &methods.PingRPCv1, peer, comp, reqresp.RequestSSZInput{Obj: ping}, &pong)
+	if err != nil {
+		return 0, err
+	}
+	return uint64(pong), nil
+}
+
+// runSingleChunkRequest runs the request of a method responding with a single chunk and decodes it in dest
+func runSingleChunkRequest(ctx context.Context, sFn reqresp.NewStreamFn, method *reqresp.RPCMethod, peer *models.Peer,
+	comp reqresp.Compression, req reqresp.RequestInput, dest codec.Deserializable) error {
+	received := false
+	err := method.RunRequest(ctx, sFn, peer.ID, comp, req, 1,
 		func() error {
 			return nil
 		},
 		func(chunk reqresp.ChunkedResponseHandler) error {
-			resCode = chunk.ResultCode()
+			resCode := chunk.ResultCode()
 			switch resCode {
 			case reqresp.ServerErrCode, reqresp.InvalidReqCode:
 				msg, err := chunk.ReadErrMsg()
 				if err != nil {
 					return fmt.Errorf("%s: %w", msg, err)
 				}
+				return fmt.Errorf("error response %d: %s", resCode, msg)
 			case reqresp.SuccessCode:
-				var stat beacon.Status
-				if err := chunk.ReadObj(&stat); err != nil {
+				if err := chunk.ReadObj(dest); err != nil {
 					return err
 				}
-				data = &stat
+				received = true
 			default:
 				return errors.New("unexpected result code")
 			}
 			return nil
 		})
-	return data, err
+	if err != nil {
+		return err
+	}
+	if !received {
+		return errors.New("no response received")
+	}
+	return nil
 }
@@ -0,0 +1,172 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package p2p
+
+import (
+	"context"
+	"eth2-crawler/crawler/rpc/methods"
+	reqresp "eth2-crawler/crawler/rpc/request"
+	"eth2-crawler/models"
+	"eth2-crawler/utils/config"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/p2p/transport/tcp"
+	beacon "github.com/protolambda/zrnt/eth2/beacon/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestHost(t *testing.T) Host {
+	return newTestHostWith(t, []string{SecurityNoise, SecurityTLS}, []string{MuxerYamux, MuxerMplex})
+}
+
+// testNetworks holds mainnet up to deneb
+func testNetworks(t *testing.T) models.Networks {
+	network, err := models.NewNetwork(&config.Network{
+		Name:                  "mainnet",
+		GenesisTime:           1606824023,
+		GenesisValidatorsRoot: "0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95",
+		SecondsPerSlot:        12,
+		SlotsPerEpoch:         32,
+		Forks: []*config.Fork{
+			{Name: "phase0", Version: "0x00000000", Epoch: 0},
+			{Name: "deneb", Version: "0x04000000", Epoch: 269568},
+		},
+	})
+	require.NoError(t, err)
+	return models.Networks{network}
+}
+
+func newTestHostWith(t *testing.T, security, muxers []string) Host {
+	h, err := NewHost(security, muxers, testNetworks(t),
+		libp2p.ListenAddrStrings("/ip4/127.0.0.1/tcp/0"),
+		libp2p.Transport(tcp.NewTCPTransport),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = h.Close() })
+	return h
+}
+
+func TestHostServesRequests(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	crawler, remote := newTestHost(t), newTestHost(t)
+	require.NoError(t, crawler.Connect(ctx, peer.AddrInfo{ID: remote.ID(), Addrs: remote.Addrs()}))
+	assert.Equal(t, []string{"tcp"}, crawler.GetTransports(remote.ID()))
+	security, err := crawler.GetSecurity(remote.ID())
+	require.NoError(t, err)
+	assert.Equal(t, SecurityNoise, security)
+	muxer, err := crawler.GetMuxer(remote.ID())
+	require.NoError(t, err)
+	assert.Equal(t, MuxerYamux, muxer)
+
+	id, err := crawler.FetchIdentify(ctx, remote.ID())
+	require.NoError(t, err)
+	assert.NotEmpty(t, id.AgentVersion)
+	assert.Contains(t, id.Protocols, string(methods.StatusRPCv1.Protocol)+"_snappy")
+	assert.NotEmpty(t, id.ListenAddrs)
+	assert.NotEmpty(t, id.ObservedAddr)
+
+	p := &models.Peer{ID: remote.ID(), ForkDigest: beacon.ForkDigest{0x6a, 0x95, 0xa1, 0xa9}}
+	comp := new(reqresp.SnappyCompression)
+
+	status, err := crawler.FetchStatus(crawler.NewStream, ctx, p, comp)
+	require.NoError(t, err)
+	assert.Equal(t, p.ForkDigest, status.ForkDigest)
+
+	// the head is set at the finalized checkpoint of the requester
+	var lagging beacon.Status
+	request := &beacon.Status{ForkDigest: p.ForkDigest, FinalizedRoot: beacon.Root{0x01}, FinalizedEpoch: 300000,
+		HeadRoot: beacon.Root{0x02}, HeadSlot: 9600100}
+	err = runSingleChunkRequest(ctx, crawler.NewStream, &methods.StatusRPCv1, p, comp,
+		reqresp.RequestSSZInput{Obj: request}, &lagging)
+	require.NoError(t, err)
+	assert.Equal(t, request.FinalizedRoot, lagging.HeadRoot)
+	assert.Equal(t, beacon.Slot(9600000), lagging.HeadSlot)
+
+	_, err = crawler.FetchStatus(crawler.NewStream, ctx, &models.Peer{ID: remote.ID()}, comp)
+	assert.Error(t, err)
+
+	rtt, err := crawler.MeasureRTT(ctx, remote.ID())
+	require.NoError(t, err)
+	assert.Positive(t, rtt)
+
+	seq, err := crawler.Ping(crawler.NewStream, ctx, p, comp)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(localSeqNumber), seq)
+
+	md, err := crawler.FetchMetaData(crawler.NewStream, ctx, p, comp)
+	require.NoError(t, err)
+	assert.Equal(t, 3, md.Version)
+	assert.Equal(t, uint64(localSeqNumber), md.SeqNumber)
+}
+
+func TestHostStoresGoodbye(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	crawler, remote := newTestHost(t), newTestHost(t)
+	require.NoError(t, remote.Connect(ctx, peer.AddrInfo{ID: crawler.ID(), Addrs: crawler.Addrs()}))
+
+	_, err := crawler.GetGoodbye(remote.ID())
+	assert.Error(t, err)
+
+	reason := beacon.Goodbye(models.GoodbyeTooManyPeers)
+	err = methods.GoodbyeRPCv1.RunRequest(ctx, remote.NewStream, crawler.ID(), new(reqresp.SnappyCompression),
+		reqresp.RequestSSZInput{Obj: reason}, 0,
+		func() error { return nil },
+		func(chunk reqresp.ChunkedResponseHandler) error { return nil })
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		goodbye, err := crawler.GetGoodbye(remote.ID())
+		return err == nil && goodbye.Reason == models.GoodbyeTooManyPeers
+	}, 5*time.Second, 50*time.Millisecond)
+}
+
+func TestHostRecordsNegotiation(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	crawler, remote := newTestHostWith(t, []string{SecurityTLS}, []string{MuxerMplex}), newTestHost(t)
+	require.NoError(t, crawler.Connect(ctx, peer.AddrInfo{ID: remote.ID(), Addrs: remote.Addrs()}))
+
+	security, err := crawler.GetSecurity(remote.ID())
+	require.NoError(t, err)
+	assert.Equal(t, SecurityTLS, security)
+	muxer, err := crawler.GetMuxer(remote.ID())
+	require.NoError(t, err)
+	assert.Equal(t, MuxerMplex, muxer)
+
+	_, err = NewHost([]string{"secio"}, []string{MuxerYamux}, nil)
+	assert.Error(t, err)
+}
+
+func TestHostDisconnect(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	crawler, remote := newTestHost(t), newTestHost(t)
+	require.NoError(t, crawler.Connect(ctx, peer.AddrInfo{ID: remote.ID(), Addrs: remote.Addrs()}))
+	metrics := crawler.ConnectionMetrics()
+	assert.Equal(t, 1, metrics.Open)
+	assert.Equal(t, 1, metrics.Outbound)
+	assert.Equal(t, uint64(1), metrics.Opened)
+
+	require.NoError(t, crawler.Disconnect(ctx, remote.ID(), models.GoodbyeClientShutdown))
+	assert.Equal(t, network.NotConnected, crawler.Network().Connectedness(remote.ID()))
+	require.Eventually(t, func() bool {
+		goodbye, err := remote.GetGoodbye(crawler.ID())
+		return err == nil && goodbye.Reason == models.GoodbyeClientShutdown
+	}, 5*time.Second, 50*time.Millisecond)
+	require.Eventually(t, func() bool {
+		metrics := crawler.ConnectionMetrics()
+		return metrics.Open == 0 && metrics.Closed == 1
+	}, 5*time.Second, 50*time.Millisecond)
+}
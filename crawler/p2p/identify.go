@@ -0,0 +1,162 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package p2p
+
+import (
+	"context"
+	"errors"
+	"eth2-crawler/models"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/event"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+)
+
+const identifyTimeout = 10 * time.Second
+
+// FetchIdentify awaits the identify exchange the host runs on the connection to the peer
+// and returns the details it stored in the peerstore
+func (c *Client) FetchIdentify(ctx context.Context, peerID peer.ID) (*models.Identify, error) {
+	conns := c.Network().ConnsToPeer(peerID)
+	if len(conns) == 0 {
+		return nil, errors.New("not connected to peer, cannot await connection identify")
+	}
+	ctx, cancel := context.WithTimeout(ctx, identifyTimeout)
+	defer cancel()
+	select {
+	case <-c.idSvc.IdentifyWait(conns[0]):
+	case <-ctx.Done():
+		return nil, fmt.Errorf("error awaiting identify: %w", ctx.Err())
+	}
+	// the agent version is stored once the identify message is received
+	agentVersion, err := c.getString(peerID, "AgentVersion")
+	if err != nil {
+		return nil, err
+	}
+	protocolVersion, err := c.getString(peerID, "ProtocolVersion")
+	if err != nil {
+		return nil, err
+	}
+	protocols, err := c.Peerstore().GetProtocols(peerID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting protocols:%w", err)
+	}
+	id := &models.Identify{
+		ProtocolVersion: protocolVersion,
+		AgentVersion:    agentVersion,
+		Protocols:       make([]string, 0, len(protocols)),
+		ListenAddrs:     make([]string, 0),
+	}
+	for _, p := range protocols {
+		id.Protocols = append(id.Protocols, string(p))
+	}
+	for _, addr := range c.Peerstore().Addrs(peerID) {
+		id.ListenAddrs = append(id.ListenAddrs, addr.String())
+	}
+	// the identify events are emitted before the identify is done, they are handled shortly after
+	obs := c.observed.get(conns[0])
+	select {
+	case <-obs.done:
+		if obs.addr != nil {
+			id.ObservedAddr = obs.addr.String()
+		}
+	case <-ctx.Done():
+	}
+	return id, nil
+}
+
+// observedAddrs holds the crawler address observed by the peer of each identified connection,
+// the identify service doesn't keep it per peer
+type observedAddrs struct {
+	mu    sync.Mutex
+	conns map[network.Conn]*observation
+}
+
+type observation struct {
+	done chan struct{} // closed once the identify of the connection is done
+	addr ma.Multiaddr  // nil when the identify failed
+}
+
+func newObservedAddrs() *observedAddrs {
+	return &observedAddrs{conns: make(map[network.Conn]*observation)}
+}
+
+// get returns the observation of the connection, the identify events may come before or after
+func (o *observedAddrs) get(conn network.Conn) *observation {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.getLocked(conn)
+}
+
+// getLocked only keeps the observations of the open connections, the closed ones are
+// already forgotten and get a done observation without address
+func (o *observedAddrs) getLocked(conn network.Conn) *observation {
+	obs, ok := o.conns[conn]
+	if !ok {
+		obs = &observation{done: make(chan struct{})}
+		if conn.IsClosed() {
+			obs.finish()
+			return obs
+		}
+		o.conns[conn] = obs
+	}
+	return obs
+}
+
+// record handles the identify events until the subscription is closed. The pushed identify
+// messages are completion events too, they update the observed address
+func (o *observedAddrs) record(sub event.Subscription) {
+	for e := range sub.Out() {
+		o.mu.Lock()
+		switch evt := e.(type) {
+		case event.EvtPeerIdentificationCompleted:
+			obs := o.getLocked(evt.Conn)
+			obs.addr = evt.ObservedAddr
+			obs.finish()
+		case event.EvtPeerIdentificationFailed:
+			// the failure is reported per peer, the pending connections of the peer are done
+			for conn, obs := range o.conns {
+				if conn.RemotePeer() == evt.Peer {
+					obs.finish()
+				}
+			}
+		}
+		o.mu.Unlock()
+	}
+}
+
+func (obs *observation) finish() {
+	select {
+	case <-obs.done:
+	default:
+		close(obs.done)
+	}
+}
+
+// Disconnected forgets the observation of the closed connection
+func (o *observedAddrs) Disconnected(_ network.Network, conn network.Conn) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	delete(o.conns, conn)
+}
+
+func (o *observedAddrs) Listen(network.Network, ma.Multiaddr)      {}
+func (o *observedAddrs) ListenClose(network.Network, ma.Multiaddr) {}
+func (o *observedAddrs) Connected(network.Network, network.Conn)   {}
+
+func (c *Client) getString(peerID peer.ID, key string) (string, error) {
+	value, err := c.Peerstore().Get(peerID, key)
+	if err != nil {
+		return "", fmt.Errorf("error getting %s:%w", key, err)
+	}
+	str, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("error converting interface to string")
+	}
+	return str, nil
+}
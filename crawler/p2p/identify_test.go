@@ -0,0 +1,36 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package p2p
+
+import (
+	"testing"
+
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/stretchr/testify/assert"
+)
+
+type testConn struct {
+	network.Conn
+	closed bool
+}
+
+func (c *testConn) IsClosed() bool { return c.closed }
+
+func TestObservedAddrsForgetClosedConns(t *testing.T) {
+	observed := newObservedAddrs()
+	open, closed := &testConn{}, &testConn{closed: true}
+
+	obs := observed.get(closed)
+	assert.Len(t, observed.conns, 0)
+	select {
+	case <-obs.done:
+	default:
+		t.Fatal("observation of a closed connection is pending")
+	}
+
+	observed.get(open)
+	assert.Len(t, observed.conns, 1)
+	observed.Disconnected(nil, open)
+	assert.Len(t, observed.conns, 0)
+}
@@ -0,0 +1,81 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package p2p
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/libp2p/go-libp2p"
+	mplex "github.com/libp2p/go-libp2p-mplex"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/protocol"
+	"github.com/libp2p/go-libp2p/p2p/muxer/yamux"
+	noise "github.com/libp2p/go-libp2p/p2p/security/noise"
+	tls "github.com/libp2p/go-libp2p/p2p/security/tls"
+)
+
+// names of the supported security transports and stream muxers
+const (
+	SecurityNoise = "noise"
+	SecurityTLS   = "tls"
+	MuxerYamux    = "yamux"
+	MuxerMplex    = "mplex"
+	// MuxerQUIC is the stream muxer of the quic connections, which multiplex the streams natively
+	MuxerQUIC = "quic"
+)
+
+// protocolNames maps the protocol IDs of the security transports and stream muxers to their names
+var protocolNames = map[protocol.ID]string{
+	noise.ID: SecurityNoise,
+	tls.ID:   SecurityTLS,
+	yamux.ID: MuxerYamux,
+	mplex.ID: MuxerMplex,
+}
+
+// securityOptions returns the libp2p options of the named security transports, in order of preference
+func securityOptions(names []string) ([]libp2p.Option, error) {
+	opts := make([]libp2p.Option, 0, len(names))
+	for _, name := range names {
+		switch name {
+		case SecurityNoise:
+			opts = append(opts, libp2p.Security(noise.ID, noise.New))
+		case SecurityTLS:
+			opts = append(opts, libp2p.Security(tls.ID, tls.New))
+		default:
+			return nil, fmt.Errorf("unknown security transport %s", name)
+		}
+	}
+	return opts, nil
+}
+
+// muxerOptions returns the libp2p options of the named stream muxers, in order of preference
+func muxerOptions(names []string) ([]libp2p.Option, error) {
+	opts := make([]libp2p.Option, 0, len(names))
+	for _, name := range names {
+		switch name {
+		case MuxerYamux:
+			opts = append(opts, libp2p.Muxer(yamux.ID, yamux.DefaultTransport))
+		case MuxerMplex:
+			opts = append(opts, libp2p.Muxer(mplex.ID, mplex.DefaultTransport))
+		default:
+			return nil, fmt.Errorf("unknown stream muxer %s", name)
+		}
+	}
+	return opts, nil
+}
+
+// negotiated returns the names of the security transport and stream muxer of the connection.
+// The quic connections are secured by tls and multiplexed by quic itself
+func negotiated(conn network.Conn) (security, muxer string, err error) {
+	state := conn.ConnState()
+	if state.Transport == "quic" || state.Transport == "quic-v1" {
+		return SecurityTLS, MuxerQUIC, nil
+	}
+	security, muxer = protocolNames[state.Security], protocolNames[state.StreamMultiplexer]
+	if security == "" || muxer == "" {
+		return "", "", errors.New("unknown connection security or muxer")
+	}
+	return security, muxer, nil
+}
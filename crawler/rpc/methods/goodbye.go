@@ -0,0 +1,18 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package methods
+
+import (
+	reqresp "eth2-crawler/crawler/rpc/request"
+
+	beacon "github.com/protolambda/zrnt/eth2/beacon/common"
+)
+
+// GoodbyeRPCv1 notifies the peer of a disconnection and its reason
+var GoodbyeRPCv1 = reqresp.RPCMethod{
+	Protocol:                  "/eth2/beacon_chain/req/goodbye/1/ssz",
+	RequestCodec:              reqresp.NewSSZCodec(func() reqresp.SerDes { return new(beacon.Goodbye) }, 8, 8),
+	ResponseChunkCodec:        reqresp.NewSSZCodec(func() reqresp.SerDes { return new(beacon.Goodbye) }, 8, 8),
+	DefaultResponseChunkCount: 0,
+}
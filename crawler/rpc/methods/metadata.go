@@ -0,0 +1,79 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package methods
+
+import (
+	reqresp "eth2-crawler/crawler/rpc/request"
+	"eth2-crawler/crawler/util"
+
+	beacon "github.com/protolambda/zrnt/eth2/beacon/common"
+	"github.com/protolambda/ztyp/codec"
+	"github.com/protolambda/ztyp/view"
+)
+
+// MetaDataV2 is the altair metadata, extended with the sync committee subnets
+type MetaDataV2 struct {
+	SeqNumber beacon.SeqNr
+	Attnets   beacon.AttnetBits
+	Syncnets  util.SyncnetBits
+}
+
+const MetaDataV2ByteLen = 8 + 8 + 1
+
+func (d *MetaDataV2) Deserialize(dr *codec.DecodingReader) error {
+	return dr.FixedLenContainer(&d.SeqNumber, &d.Attnets, &d.Syncnets)
+}
+
+func (d *MetaDataV2) Serialize(w *codec.EncodingWriter) error {
+	return w.FixedLenContainer(&d.SeqNumber, &d.Attnets, &d.Syncnets)
+}
+
+func (d *MetaDataV2) ByteLength() uint64 {
+	return MetaDataV2ByteLen
+}
+
+func (*MetaDataV2) FixedLength() uint64 {
+	return MetaDataV2ByteLen
+}
+
+// MetaDataV3 is the fulu metadata, extended with the PeerDAS custody group count
+type MetaDataV3 struct {
+	SeqNumber         beacon.SeqNr
+	Attnets           beacon.AttnetBits
+	Syncnets          util.SyncnetBits
+	CustodyGroupCount view.Uint64View
+}
+
+const MetaDataV3ByteLen = MetaDataV2ByteLen + 8
+
+func (d *MetaDataV3) Deserialize(dr *codec.DecodingReader) error {
+	return dr.FixedLenContainer(&d.SeqNumber, &d.Attnets, &d.Syncnets, &d.CustodyGroupCount)
+}
+
+func (d *MetaDataV3) Serialize(w *codec.EncodingWriter) error {
+	return w.FixedLenContainer(&d.SeqNumber, &d.Attnets, &d.Syncnets, &d.CustodyGroupCount)
+}
+
+func (d *MetaDataV3) ByteLength() uint64 {
+	return MetaDataV3ByteLen
+}
+
+func (*MetaDataV3) FixedLength() uint64 {
+	return MetaDataV3ByteLen
+}
+
+// MetaData requests have no content
+var MetaDataRPCv2 = reqresp.RPCMethod{
+	Protocol:                  "/eth2/beacon_chain/req/metadata/2/ssz",
+	RequestCodec:              (*reqresp.SSZCodec)(nil),
+	ResponseChunkCodec:        reqresp.NewSSZCodec(func() reqresp.SerDes { return new(MetaDataV2) }, MetaDataV2ByteLen, MetaDataV2ByteLen),
+	DefaultResponseChunkCount: 1,
+}
+
+var MetaDataRPCv3 = reqresp.RPCMethod{
+	Protocol:                  "/eth2/beacon_chain/req/metadata/3/ssz",
+	RequestCodec:              (*reqresp.SSZCodec)(nil),
+	ResponseChunkCodec:        reqresp.NewSSZCodec(func() reqresp.SerDes { return new(MetaDataV3) }, MetaDataV3ByteLen, MetaDataV3ByteLen),
+	DefaultResponseChunkCount: 1,
+}
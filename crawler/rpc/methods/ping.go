@@ -0,0 +1,18 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package methods
+
+import (
+	reqresp "eth2-crawler/crawler/rpc/request"
+
+	beacon "github.com/protolambda/zrnt/eth2/beacon/common"
+)
+
+// PingRPCv1 exchanges the metadata sequence numbers of both peers
+var PingRPCv1 = reqresp.RPCMethod{
+	Protocol:                  "/eth2/beacon_chain/req/ping/1/ssz",
+	RequestCodec:              reqresp.NewSSZCodec(func() reqresp.SerDes { return new(beacon.Ping) }, 8, 8),
+	ResponseChunkCodec:        reqresp.NewSSZCodec(func() reqresp.SerDes { return new(beacon.Pong) }, 8, 8),
+	DefaultResponseChunkCount: 1,
+}
@@ -11,9 +11,9 @@ import (
 	"fmt"
 	"io"
 
-	"github.com/libp2p/go-libp2p-core/network"
-	"github.com/libp2p/go-libp2p-core/peer"
-	"github.com/libp2p/go-libp2p-core/protocol"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/protocol"
 	"github.com/protolambda/ztyp/codec"
 )
 
@@ -189,9 +189,13 @@ func (m *RPCMethod) RunRequest(ctx context.Context, newStreamFn NewStreamFn,
 		})
 	})
 
-	reqR, err := req.Reader(m.RequestCodec)
-	if err != nil {
-		return err
+	var reqR io.Reader
+	if m.RequestCodec.MaxByteLen() > 0 {
+		var err error
+		reqR, err = req.Reader(m.RequestCodec)
+		if err != nil {
+			return err
+		}
 	}
 
 	protocolID := m.Protocol
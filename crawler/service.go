@@ -6,17 +6,20 @@ package crawler
 
 import (
 	"eth2-crawler/crawler/crawl"
+	"eth2-crawler/crawler/p2p"
+	"eth2-crawler/models"
 	ipResolver "eth2-crawler/resolver"
+	"eth2-crawler/store/event"
 	"eth2-crawler/store/peerstore"
 	"eth2-crawler/store/record"
+	"eth2-crawler/utils/config"
 
 	"github.com/ethereum/go-ethereum/log"
-
-	"github.com/ethereum/go-ethereum/params"
 )
 
-// Start starts the crawler service
-func Start(peerStore peerstore.Provider, historyStore record.Provider, ipResolver ipResolver.Provider) {
+// Start starts the crawler service for the given networks and returns its connection metrics
+func Start(cfg *config.Crawler, peerStore peerstore.Provider, historyStore record.Provider, eventStore event.Provider,
+	ipResolver ipResolver.Provider, networks models.Networks, uaParser *models.UserAgentParser) p2p.Metrics {
 	h := log.CallerFileHandler(log.StdoutHandler)
 	log.Root().SetHandler(h)
 
@@ -25,8 +28,9 @@ func Start(peerStore peerstore.Provider, historyStore record.Provider, ipResolve
 	)
 	log.Root().SetHandler(handler)
 
-	err := crawl.Initialize(peerStore, historyStore, ipResolver, params.V5Bootnodes)
+	metrics, err := crawl.Initialize(cfg, peerStore, historyStore, eventStore, ipResolver, networks, uaParser)
 	if err != nil {
 		panic(err)
 	}
+	return metrics
 }
@@ -11,9 +11,11 @@ import (
 	"net"
 	"time"
 
+	gcrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/p2p/enode"
-	"github.com/libp2p/go-libp2p-core/crypto"
-	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/multiformats/go-multiaddr"
 	beacon "github.com/protolambda/zrnt/eth2/beacon/common"
 	"github.com/protolambda/ztyp/codec"
@@ -42,33 +44,92 @@ func AddrsFromEnode(node *enode.Node) (*peer.AddrInfo, error) {
 	return peerInfo, nil
 }
 
+// EnodeToMultiAddr returns the tcp and quic multiaddrs of the ipv4 and ipv6 endpoints of the node.
+// The ipv6 ports default to the ipv4 ones when the record doesn't have them
 func EnodeToMultiAddr(node *enode.Node) ([]multiaddr.Multiaddr, error) {
 	multiAddrs := []multiaddr.Multiaddr{}
 
-	ipScheme := "ip4"
-	if len(node.IP()) == net.IPv6len {
-		ipScheme = "ip6"
-	}
-	pubkey := node.Pubkey()
-	peerID, err := peer.IDFromPublicKey(crypto.PubKey((*crypto.Secp256k1PublicKey)(pubkey)))
+	peerID, err := PeerIDFromNode(node)
 	if err != nil {
 		return nil, err
 	}
-	tcpMultiAddrStr := fmt.Sprintf("/%s/%s/tcp/%d/p2p/%s", ipScheme, node.IP().String(), node.TCP(), peerID)
-	tcpMultiAddr, err := multiaddr.NewMultiaddr(tcpMultiAddrStr)
-	if err != nil {
-		return nil, err
+	var tcp enr.TCP
+	var quic QUICENREntry
+	_ = node.Load(&tcp)
+	_ = node.Load(&quic)
+
+	var ip4 enr.IPv4
+	if node.Load(&ip4) == nil {
+		addrs, err := endpointMultiAddrs("ip4", net.IP(ip4), int(tcp), int(quic), peerID)
+		if err != nil {
+			return nil, err
+		}
+		multiAddrs = append(multiAddrs, addrs...)
+	}
+	var ip6 enr.IPv6
+	if node.Load(&ip6) == nil {
+		tcp6, quic6 := enr.TCP6(tcp), QUIC6ENREntry(quic)
+		_ = node.Load(&tcp6)
+		_ = node.Load(&quic6)
+		addrs, err := endpointMultiAddrs("ip6", net.IP(ip6), int(tcp6), int(quic6), peerID)
+		if err != nil {
+			return nil, err
+		}
+		multiAddrs = append(multiAddrs, addrs...)
+	}
+	return multiAddrs, nil
+}
+
+func endpointMultiAddrs(ipScheme string, ip net.IP, tcp, quic int, peerID peer.ID) ([]multiaddr.Multiaddr, error) {
+	multiAddrs := []multiaddr.Multiaddr{}
+	if tcp != 0 {
+		tcpMultiAddr, err := multiaddr.NewMultiaddr(fmt.Sprintf("/%s/%s/tcp/%d/p2p/%s", ipScheme, ip.String(), tcp, peerID))
+		if err != nil {
+			return nil, err
+		}
+		multiAddrs = append(multiAddrs, tcpMultiAddr)
+	}
+	if quic != 0 {
+		quicMultiAddr, err := multiaddr.NewMultiaddr(fmt.Sprintf("/%s/%s/udp/%d/quic-v1/p2p/%s", ipScheme, ip.String(), quic, peerID))
+		if err != nil {
+			return nil, err
+		}
+		multiAddrs = append(multiAddrs, quicMultiAddr)
+	}
+	return multiAddrs, nil
+}
+
+// HasLibp2pPort checks the node record exports a tcp or quic port over ipv4 or ipv6
+func HasLibp2pPort(node *enode.Node) bool {
+	var tcp6 enr.TCP6
+	var quic QUICENREntry
+	var quic6 QUIC6ENREntry
+	return node.TCP() != 0 || node.Load(&tcp6) == nil || node.Load(&quic) == nil || node.Load(&quic6) == nil
+}
+
+// MultiAddrTransport returns the name of the libp2p transport used by the multiaddr
+func MultiAddrTransport(addr multiaddr.Multiaddr) string {
+	if _, err := addr.ValueForProtocol(multiaddr.P_QUIC_V1); err == nil {
+		return "quic"
+	}
+	if _, err := addr.ValueForProtocol(multiaddr.P_QUIC); err == nil {
+		return "quic"
 	}
-	multiAddrs = append(multiAddrs, tcpMultiAddr)
+	return "tcp"
+}
 
-	udpMultiAddrStr := fmt.Sprintf("/%s/%s/udp/%d/p2p/%s", ipScheme, node.IP().String(), node.UDP(), peerID)
-	udpMultiAddr, err := multiaddr.NewMultiaddr(udpMultiAddrStr)
+// PeerIDFromNode returns the libp2p peer ID of the node, derived from its public key
+func PeerIDFromNode(node *enode.Node) (peer.ID, error) {
+	pk, err := PubKeyFromNode(node)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
-	multiAddrs = append(multiAddrs, udpMultiAddr)
+	return peer.IDFromPublicKey(pk)
+}
 
-	return multiAddrs, nil
+// PubKeyFromNode returns the libp2p public key of the node
+func PubKeyFromNode(node *enode.Node) (crypto.PubKey, error) {
+	return crypto.UnmarshalSecp256k1PublicKey(gcrypto.CompressPubkey(node.Pubkey()))
 }
 
 type Eth2ENREntry []byte
@@ -136,12 +197,104 @@ func (aee AttnetsENREntry) String() string {
 	return hex.EncodeToString(aee)
 }
 
-func getGenesisTime() time.Time {
-	t, _ := time.Parse(time.RFC822, "01 Dec 20 12:00 GMT")
-	return t
+func ParseEnrSyncnets(n *enode.Node) (*SyncnetBits, error) {
+	var syncnets SyncnetsENREntry
+	if err := n.Load(&syncnets); err != nil {
+		return nil, err
+	}
+	dat, err := syncnets.SyncnetBits()
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing syncnets bytes: %w", err)
+	}
+	return &dat, nil
+}
+
+type SyncnetsENREntry []byte
+
+func (see SyncnetsENREntry) ENRKey() string {
+	return "syncnets"
+}
+
+func (see SyncnetsENREntry) SyncnetBits() (SyncnetBits, error) {
+	var dat SyncnetBits
+	if err := dat.Deserialize(codec.NewDecodingReader(bytes.NewReader(see), uint64(len(see)))); err != nil {
+		return SyncnetBits{}, err
+	}
+	return dat, nil
+}
+
+func (see SyncnetsENREntry) String() string {
+	return hex.EncodeToString(see)
+}
+
+// QUICENREntry is the udp port of the libp2p quic transport over ipv4
+type QUICENREntry uint16
+
+func (QUICENREntry) ENRKey() string {
+	return "quic"
+}
+
+// QUIC6ENREntry is the udp port of the libp2p quic transport over ipv6
+type QUIC6ENREntry uint16
+
+func (QUIC6ENREntry) ENRKey() string {
+	return "quic6"
+}
+
+// CustodyGroupCountENREntry is the number of PeerDAS custody groups of the node
+type CustodyGroupCountENREntry uint64
+
+func (CustodyGroupCountENREntry) ENRKey() string {
+	return "cgc"
+}
+
+// NextForkDigestENREntry is the digest of the next fork, or blob schedule change, of the node.
+// It is zero when none is scheduled
+type NextForkDigestENREntry []byte
+
+func (NextForkDigestENREntry) ENRKey() string {
+	return "nfd"
+}
+
+// ForkDigest returns the digest of the entry
+func (e NextForkDigestENREntry) ForkDigest() (beacon.ForkDigest, error) {
+	var digest beacon.ForkDigest
+	if len(e) != len(digest) {
+		return digest, fmt.Errorf("invalid next fork digest length %d", len(e))
+	}
+	copy(digest[:], e)
+	return digest, nil
+}
+
+// syncnetByteLen is the byte length of the sync committee subnets bitvector, SYNC_COMMITTEE_SUBNET_COUNT is 4
+const syncnetByteLen = 1
+
+// SyncnetBits is the bitvector of the sync committee subnets a node is subscribed to
+type SyncnetBits [syncnetByteLen]byte
+
+func (sb *SyncnetBits) Deserialize(dr *codec.DecodingReader) error {
+	_, err := dr.Read(sb[:])
+	return err
+}
+
+func (sb SyncnetBits) Serialize(w *codec.EncodingWriter) error {
+	return w.Write(sb[:])
+}
+
+func (sb SyncnetBits) ByteLength() uint64 {
+	return syncnetByteLen
+}
+
+func (SyncnetBits) FixedLength() uint64 {
+	return syncnetByteLen
+}
+
+func (sb SyncnetBits) String() string {
+	return "0x" + hex.EncodeToString(sb[:])
 }
 
-func CurrentBlock() int64 {
-	duration := time.Since(getGenesisTime())
-	return int64((duration / time.Second) / 12)
+// CurrentSlot returns the current slot of a chain started at genesisTime
+func CurrentSlot(genesisTime time.Time, secondsPerSlot uint64) int64 {
+	duration := time.Since(genesisTime)
+	return int64(duration/time.Second) / int64(secondsPerSlot)
 }
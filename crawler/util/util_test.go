@@ -10,10 +10,11 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
-func TestCurrentBlock(t *testing.T) {
-	block1 := CurrentBlock()
-	assert.Greater(t, block1, int64(0))
+func TestCurrentSlot(t *testing.T) {
+	genesis := time.Unix(1606824023, 0)
+	slot1 := CurrentSlot(genesis, 12)
+	assert.Greater(t, slot1, int64(0))
 	time.Sleep(12 * time.Second)
-	block2 := CurrentBlock()
-	assert.Equal(t, block1, block2-1)
+	slot2 := CurrentSlot(genesis, 12)
+	assert.Equal(t, slot1, slot2-1)
 }
@@ -5,10 +5,17 @@
 package model
 
 import (
+	"eth2-crawler/crawler/util"
 	svcModels "eth2-crawler/models"
 	"fmt"
+	"net"
+	"strconv"
+	"strings"
 
-	"github.com/hashicorp/go-version"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/protolambda/zrnt/eth2/beacon/common"
 )
 
 func SortByCount(data []*NextHardforkAggregation) []*NextHardforkAggregation {
@@ -22,12 +29,19 @@ func SortByCount(data []*NextHardforkAggregation) []*NextHardforkAggregation {
 	return data
 }
 
-func GroupByHardforkSchedule(allPeers []*svcModels.Peer) map[string]*NextHardforkAggregation {
+// GroupByHardforkSchedule counts the peers announcing each next fork, named after the fork
+// schedule of their network
+func GroupByHardforkSchedule(allPeers []*svcModels.Peer, networks svcModels.Networks) map[string]*NextHardforkAggregation {
 	result := map[string]*NextHardforkAggregation{}
 	for _, peer := range allPeers {
 		key := fmt.Sprintf("%s-%s", peer.NextForkVersion.String(), peer.NextForkEpoch.String())
 		if _, ok := result[key]; !ok {
+			name := ""
+			if network := networks.ByName(peer.Network); network != nil {
+				name = network.NextForkName(peer.NextForkVersion, common.Epoch(peer.NextForkEpoch))
+			}
 			result[key] = &NextHardforkAggregation{
+				Name:    name,
 				Epoch:   peer.NextForkEpoch.String(),
 				Version: peer.NextForkVersion.String(),
 				Count:   1,
@@ -39,43 +53,150 @@ func GroupByHardforkSchedule(allPeers []*svcModels.Peer) map[string]*NextHardfor
 	return result
 }
 
-func SupportAltairUpgrade(clientName, ver string) bool {
-	if len(ver) != 0 && ver[0:1] != "v" {
-		ver = "v" + ver
+// NewForkReadiness returns the graph readiness of the network peers for a fork
+func NewForkReadiness(readiness *svcModels.ForkReadiness) *ForkReadiness {
+	clients := []*ClientForkReadiness{}
+	for _, c := range readiness.Clients {
+		clients = append(clients, &ClientForkReadiness{
+			Client:          string(c.Client),
+			Total:           c.Total,
+			ReadyByVersion:  c.ReadyByVersion,
+			ReadyBySchedule: c.ReadyBySchedule,
+			Ready:           c.Ready,
+		})
 	}
-	clientVersion, err := version.NewVersion(ver)
-	if err != nil {
-		return false
+	return &ForkReadiness{
+		Network:         readiness.Network,
+		Fork:            readiness.Fork,
+		Epoch:           strconv.FormatUint(uint64(readiness.Epoch), 10),
+		Total:           readiness.Total,
+		ReadyByVersion:  readiness.ReadyByVersion,
+		ReadyBySchedule: readiness.ReadyBySchedule,
+		Ready:           readiness.Ready,
+		ReadyPercentage: readiness.ReadyPercentage(),
+		Clients:         clients,
 	}
+}
 
-	switch svcModels.ClientName(clientName) {
-	case svcModels.PrysmClient:
-		v, _ := version.NewVersion("v2.0.0")
-		if clientVersion.GreaterThanOrEqual(v) {
-			return true
-		}
-	case svcModels.TekuClient:
-		v, _ := version.NewVersion("v21.9.2")
-		if clientVersion.GreaterThanOrEqual(v) {
-			return true
-		}
-	case svcModels.LighthouseClient:
-		v, _ := version.NewVersion("v2.0.0")
-		if clientVersion.GreaterThanOrEqual(v) {
-			return true
-		}
-	case svcModels.NimbusClient:
-		v, _ := version.NewVersion("v1.5.0")
-		if clientVersion.GreaterThanOrEqual(v) {
-			return true
-		}
-	case svcModels.LodestarClient:
-		v, _ := version.NewVersion("v0.31.0")
-		if clientVersion.GreaterThanOrEqual(v) {
-			return true
+// NewScheduleStatus returns the graph enum of a fork schedule status
+func NewScheduleStatus(status svcModels.ScheduleStatus) ScheduleStatus {
+	return ScheduleStatus(strings.ToUpper(string(status)))
+}
+
+// NewMisconfiguredPeer returns the graph peer of a schedule check
+func NewMisconfiguredPeer(check *svcModels.ScheduleCheck) *MisconfiguredPeer {
+	client, ver := string(svcModels.OthersClient), svcModels.VersionUnknown
+	if ua := check.Peer.UserAgent; ua != nil {
+		client, ver = string(ua.Name), ua.Version
+	}
+	return &MisconfiguredPeer{
+		Peer:            NewPeer(check.Peer),
+		Status:          NewScheduleStatus(check.Status),
+		Client:          client,
+		Version:         ver,
+		NextForkVersion: check.Peer.NextForkVersion.String(),
+		NextForkEpoch:   check.Peer.NextForkEpoch.String(),
+		NextForkDigest:  check.Peer.NextForkDigest.String(),
+		UpcomingFork:    check.UpcomingFork,
+	}
+}
+
+// NewPeer returns the graph peer of a stored peer
+func NewPeer(peer *svcModels.Peer) *Peer {
+	// the lists are not nullable, peers not identified yet have none
+	return &Peer{
+		ID:           peer.ID.String(),
+		NodeID:       peer.NodeID,
+		Enr:          peer.ENR,
+		Seq:          strconv.FormatUint(peer.Seq, 10),
+		Network:      peer.Network,
+		IP:           peer.IP,
+		IP6:          peer.IP6,
+		TCPPort:      peer.TCPPort,
+		UDPPort:      peer.UDPPort,
+		ForkDigest:   peer.ForkDigestStr,
+		ForkName:     peer.ForkName,
+		UserAgent:    peer.UserAgentRaw,
+		Protocols:    append([]string{}, peer.Protocols...),
+		ListenAddrs:  append([]string{}, peer.ListenAddrs...),
+		ObservedAddr: peer.ObservedAddr,
+	}
+}
+
+// DecodeEnr decodes a node record, its signature is verified
+func DecodeEnr(source string) (*DecodedEnr, error) {
+	node, err := util.ParseNode(source)
+	if err != nil {
+		return nil, fmt.Errorf("invalid enr: %w", err)
+	}
+	peerID, err := util.PeerIDFromNode(node)
+	if err != nil {
+		return nil, err
+	}
+	result := &DecodedEnr{
+		Seq:    strconv.FormatUint(node.Seq(), 10),
+		NodeID: node.ID().String(),
+		PeerID: peerID.String(),
+		Pubkey: fmt.Sprintf("0x%x", crypto.CompressPubkey(node.Pubkey())),
+		Keys:   util.RecordKeys(node.Record()),
+	}
+	var ip4 enr.IPv4
+	if node.Load(&ip4) == nil {
+		result.IP = stringPtr(net.IP(ip4).String())
+	}
+	var ip6 enr.IPv6
+	if node.Load(&ip6) == nil {
+		result.IP6 = stringPtr(net.IP(ip6).String())
+	}
+	result.TCPPort = loadPort(node, new(enr.TCP))
+	result.UDPPort = loadPort(node, new(enr.UDP))
+	result.QuicPort = loadPort(node, new(util.QUICENREntry))
+	result.TCP6Port = loadPort(node, new(enr.TCP6))
+	result.UDP6Port = loadPort(node, new(enr.UDP6))
+	result.Quic6Port = loadPort(node, new(util.QUIC6ENREntry))
+	if eth2Data, err := util.ParseEnrEth2Data(node); err == nil {
+		result.Eth2 = &Eth2Data{
+			ForkDigest:      eth2Data.ForkDigest.String(),
+			NextForkVersion: eth2Data.NextForkVersion.String(),
+			NextForkEpoch:   strconv.FormatUint(uint64(eth2Data.NextForkEpoch), 10),
 		}
-	default:
-		return false
 	}
-	return false
+	if attnets, err := util.ParseEnrAttnets(node); err == nil {
+		result.Attnets = stringPtr(fmt.Sprintf("0x%x", attnets[:]))
+	}
+	if syncnets, err := util.ParseEnrSyncnets(node); err == nil {
+		result.Syncnets = stringPtr(syncnets.String())
+	}
+	var cgc util.CustodyGroupCountENREntry
+	if node.Load(&cgc) == nil {
+		result.CustodyGroupCount = stringPtr(strconv.FormatUint(uint64(cgc), 10))
+	}
+	return result, nil
+}
+
+// loadPort returns the port of the record entry, nil if the record doesn't have it
+func loadPort(node *enode.Node, entry enr.Entry) *int {
+	if node.Load(entry) != nil {
+		return nil
+	}
+	var port int
+	switch p := entry.(type) {
+	case *enr.TCP:
+		port = int(*p)
+	case *enr.UDP:
+		port = int(*p)
+	case *enr.TCP6:
+		port = int(*p)
+	case *enr.UDP6:
+		port = int(*p)
+	case *util.QUICENREntry:
+		port = int(*p)
+	case *util.QUIC6ENREntry:
+		port = int(*p)
+	}
+	return &port
+}
+
+func stringPtr(s string) *string {
+	return &s
 }
@@ -0,0 +1,42 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package model
+
+import (
+	"testing"
+
+	svcModels "eth2-crawler/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Teku team's mainnet bootnode
+const tekuBootnode = "enr:-KG4QOtcP9X1FbIMOe17QNMKqDxCpm14jcX5tiOE4_TyMrFqbmhPZHK_ZPG2Gxb1GE2xdtodOfx9-cgvNtxnRyHEmC0ghGV0aDKQ9aX9QgAAAAD__________4JpZIJ2NIJpcIQDE8KdiXNlY3AyNTZrMaEDhpehBDbZjM_L9ek699Y7vhUJ-eAdMyQW_Fil522Y0fODdGNwgiMog3VkcIIjKA"
+
+func TestDecodeEnr(t *testing.T) {
+	decoded, err := DecodeEnr(tekuBootnode)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"eth2", "id", "ip", "secp256k1", "tcp", "udp"}, decoded.Keys)
+	require.NotNil(t, decoded.IP)
+	assert.Equal(t, "3.19.194.157", *decoded.IP)
+	assert.Nil(t, decoded.IP6)
+	require.NotNil(t, decoded.TCPPort)
+	assert.Equal(t, 9000, *decoded.TCPPort)
+	require.NotNil(t, decoded.Eth2)
+	assert.Equal(t, "0xf5a5fd42", decoded.Eth2.ForkDigest)
+	assert.Nil(t, decoded.Attnets)
+	assert.NotEmpty(t, decoded.PeerID)
+
+	_, err = DecodeEnr("enr:invalid")
+	assert.Error(t, err)
+}
+
+func TestNewScheduleStatus(t *testing.T) {
+	for _, status := range []svcModels.ScheduleStatus{
+		svcModels.ScheduleCorrect, svcModels.ScheduleMissingFork, svcModels.ScheduleWrongFork, svcModels.ScheduleUnknownFork,
+	} {
+		assert.True(t, NewScheduleStatus(status).IsValid(), status)
+	}
+}
@@ -2,17 +2,88 @@
 
 package model
 
+import (
+	"fmt"
+	"io"
+	"strconv"
+)
+
 type AggregateData struct {
 	Name  string `json:"name"`
 	Count int    `json:"count"`
 }
 
+type CheckpointAggregation struct {
+	Epoch  string `json:"epoch"`
+	Root   string `json:"root"`
+	Count  int    `json:"count"`
+	Behind int    `json:"behind"`
+}
+
+type ClientForkReadiness struct {
+	Client          string `json:"client"`
+	Total           int    `json:"total"`
+	ReadyByVersion  int    `json:"readyByVersion"`
+	ReadyBySchedule int    `json:"readyBySchedule"`
+	Ready           int    `json:"ready"`
+}
+
+type ClientSyncLag struct {
+	Client  string           `json:"client"`
+	Count   int              `json:"count"`
+	Buckets []*SyncLagBucket `json:"buckets"`
+}
+
 type ClientVersionAggregation struct {
 	Client   string           `json:"client"`
 	Count    int              `json:"count"`
 	Versions []*AggregateData `json:"versions"`
 }
 
+type DecodedEnr struct {
+	Seq               string    `json:"seq"`
+	NodeID            string    `json:"nodeId"`
+	PeerID            string    `json:"peerId"`
+	Pubkey            string    `json:"pubkey"`
+	Keys              []string  `json:"keys"`
+	IP                *string   `json:"ip"`
+	IP6               *string   `json:"ip6"`
+	TCPPort           *int      `json:"tcpPort"`
+	UDPPort           *int      `json:"udpPort"`
+	QuicPort          *int      `json:"quicPort"`
+	TCP6Port          *int      `json:"tcp6Port"`
+	UDP6Port          *int      `json:"udp6Port"`
+	Quic6Port         *int      `json:"quic6Port"`
+	Eth2              *Eth2Data `json:"eth2"`
+	Attnets           *string   `json:"attnets"`
+	Syncnets          *string   `json:"syncnets"`
+	CustodyGroupCount *string   `json:"custodyGroupCount"`
+}
+
+type Eth2Data struct {
+	ForkDigest      string `json:"forkDigest"`
+	NextForkVersion string `json:"nextForkVersion"`
+	NextForkEpoch   string `json:"nextForkEpoch"`
+}
+
+type ForkReadiness struct {
+	Network         string                 `json:"network"`
+	Fork            string                 `json:"fork"`
+	Epoch           string                 `json:"epoch"`
+	Total           int                    `json:"total"`
+	ReadyByVersion  int                    `json:"readyByVersion"`
+	ReadyBySchedule int                    `json:"readyBySchedule"`
+	Ready           int                    `json:"ready"`
+	ReadyPercentage float64                `json:"readyPercentage"`
+	Clients         []*ClientForkReadiness `json:"clients"`
+}
+
+type HeadAggregation struct {
+	Slot  string `json:"slot"`
+	Root  string `json:"root"`
+	Count int    `json:"count"`
+}
+
 type HeatmapData struct {
 	NetworkType string  `json:"networkType"`
 	ClientType  string  `json:"clientType"`
@@ -23,7 +94,34 @@ type HeatmapData struct {
 	Country     string  `json:"country"`
 }
 
+type LatencyPercentiles struct {
+	Name  string  `json:"name"`
+	Count int     `json:"count"`
+	P50   float64 `json:"p50"`
+	P90   float64 `json:"p90"`
+	P99   float64 `json:"p99"`
+}
+
+type MisconfigurationAggregation struct {
+	Client  string         `json:"client"`
+	Version string         `json:"version"`
+	Status  ScheduleStatus `json:"status"`
+	Count   int            `json:"count"`
+}
+
+type MisconfiguredPeer struct {
+	Peer            *Peer          `json:"peer"`
+	Status          ScheduleStatus `json:"status"`
+	Client          string         `json:"client"`
+	Version         string         `json:"version"`
+	NextForkVersion string         `json:"nextForkVersion"`
+	NextForkEpoch   string         `json:"nextForkEpoch"`
+	NextForkDigest  string         `json:"nextForkDigest"`
+	UpcomingFork    string         `json:"upcomingFork"`
+}
+
 type NextHardforkAggregation struct {
+	Name    string `json:"name"`
 	Version string `json:"version"`
 	Epoch   string `json:"epoch"`
 	Count   int    `json:"count"`
@@ -42,8 +140,28 @@ type NodeStatsOverTime struct {
 	UnsyncedNodes int     `json:"unsyncedNodes"`
 }
 
+type Peer struct {
+	ID           string   `json:"id"`
+	NodeID       string   `json:"nodeId"`
+	Enr          string   `json:"enr"`
+	Seq          string   `json:"seq"`
+	Network      string   `json:"network"`
+	IP           string   `json:"ip"`
+	IP6          string   `json:"ip6"`
+	TCPPort      int      `json:"tcpPort"`
+	UDPPort      int      `json:"udpPort"`
+	ForkDigest   string   `json:"forkDigest"`
+	ForkName     string   `json:"forkName"`
+	UserAgent    string   `json:"userAgent"`
+	Protocols    []string `json:"protocols"`
+	ListenAddrs  []string `json:"listenAddrs"`
+	ObservedAddr string   `json:"observedAddr"`
+}
+
 type PeerFilter struct {
 	ForkDigest *string `json:"forkDigest"`
+	Network    *string `json:"network"`
+	ForkName   *string `json:"forkName"`
 }
 
 type RegionalStats struct {
@@ -51,3 +169,96 @@ type RegionalStats struct {
 	HostedNodePercentage        float64 `json:"hostedNodePercentage"`
 	NonhostedNodePercentage     float64 `json:"nonhostedNodePercentage"`
 }
+
+type SyncLagBucket struct {
+	Le    string `json:"le"`
+	Count int    `json:"count"`
+}
+
+type LatencyGroup string
+
+const (
+	LatencyGroupCountry LatencyGroup = "COUNTRY"
+	LatencyGroupAsnType LatencyGroup = "ASN_TYPE"
+	LatencyGroupClient  LatencyGroup = "CLIENT"
+)
+
+var AllLatencyGroup = []LatencyGroup{
+	LatencyGroupCountry,
+	LatencyGroupAsnType,
+	LatencyGroupClient,
+}
+
+func (e LatencyGroup) IsValid() bool {
+	switch e {
+	case LatencyGroupCountry, LatencyGroupAsnType, LatencyGroupClient:
+		return true
+	}
+	return false
+}
+
+func (e LatencyGroup) String() string {
+	return string(e)
+}
+
+func (e *LatencyGroup) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = LatencyGroup(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid LatencyGroup", str)
+	}
+	return nil
+}
+
+func (e LatencyGroup) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type ScheduleStatus string
+
+const (
+	ScheduleStatusCorrect     ScheduleStatus = "CORRECT"
+	ScheduleStatusMissingFork ScheduleStatus = "MISSING_FORK"
+	ScheduleStatusWrongFork   ScheduleStatus = "WRONG_FORK"
+	ScheduleStatusUnknownFork ScheduleStatus = "UNKNOWN_FORK"
+)
+
+var AllScheduleStatus = []ScheduleStatus{
+	ScheduleStatusCorrect,
+	ScheduleStatusMissingFork,
+	ScheduleStatusWrongFork,
+	ScheduleStatusUnknownFork,
+}
+
+func (e ScheduleStatus) IsValid() bool {
+	switch e {
+	case ScheduleStatusCorrect, ScheduleStatusMissingFork, ScheduleStatusWrongFork, ScheduleStatusUnknownFork:
+		return true
+	}
+	return false
+}
+
+func (e ScheduleStatus) String() string {
+	return string(e)
+}
+
+func (e *ScheduleStatus) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ScheduleStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ScheduleStatus", str)
+	}
+	return nil
+}
+
+func (e ScheduleStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
@@ -6,6 +6,7 @@
 package graph
 
 import (
+	"eth2-crawler/models"
 	"eth2-crawler/store/peerstore"
 	"eth2-crawler/store/record"
 )
@@ -15,10 +16,20 @@ import (
 // It serves as dependency injection for your app, add any dependencies you require here.
 
 type Resolver struct {
-	peerStore    peerstore.Provider
-	historyStore record.Provider
+	peerStore        peerstore.Provider
+	historyStore     record.Provider
+	networks         models.Networks
+	forkRequirements models.ForkRequirements
+	uaParser         *models.UserAgentParser
 }
 
-func NewResolver(peerStore peerstore.Provider, historyStore record.Provider) *Resolver {
-	return &Resolver{peerStore: peerStore, historyStore: historyStore}
+func NewResolver(peerStore peerstore.Provider, historyStore record.Provider, networks models.Networks,
+	forkRequirements models.ForkRequirements, uaParser *models.UserAgentParser) *Resolver {
+	return &Resolver{
+		peerStore:        peerStore,
+		historyStore:     historyStore,
+		networks:         networks,
+		forkRequirements: forkRequirements,
+		uaParser:         uaParser,
+	}
 }
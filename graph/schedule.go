@@ -0,0 +1,44 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package graph
+
+import (
+	"context"
+
+	"eth2-crawler/graph/model"
+	svcModels "eth2-crawler/models"
+)
+
+// forEachNetwork calls fn with the peers of each network matching the filter
+func (r *Resolver) forEachNetwork(ctx context.Context, networks svcModels.Networks, peerFilter *model.PeerFilter,
+	fn func(network *svcModels.Network, peers []*svcModels.Peer) error) error {
+	for _, network := range networks {
+		if peerFilter != nil && peerFilter.Network != nil && *peerFilter.Network != network.Name {
+			continue
+		}
+		filter := model.PeerFilter{}
+		if peerFilter != nil {
+			filter = *peerFilter
+		}
+		filter.Network = &network.Name
+		peers, err := r.peerStore.ViewAll(ctx, &filter)
+		if err != nil {
+			return err
+		}
+		if err := fn(network, peers); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// scheduleChecks classifies the fork schedule of the peers of every network matching the filter
+func (r *Resolver) scheduleChecks(ctx context.Context, peerFilter *model.PeerFilter) ([]*svcModels.ScheduleCheck, error) {
+	var checks []*svcModels.ScheduleCheck
+	err := r.forEachNetwork(ctx, r.networks, peerFilter, func(network *svcModels.Network, peers []*svcModels.Peer) error {
+		checks = append(checks, network.CheckSchedules(peers, network.CurrentEpoch())...)
+		return nil
+	})
+	return checks, err
+}
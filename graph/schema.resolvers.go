@@ -8,11 +8,27 @@ package graph
 
 import (
 	"context"
+	"errors"
 	"eth2-crawler/graph/generated"
 	"eth2-crawler/graph/model"
 	svcModels "eth2-crawler/models"
+	"eth2-crawler/store/peerstore"
+	"eth2-crawler/utils/server"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/libp2p/go-libp2p/core/peer"
 )
 
+// ReparseUserAgents is the resolver for the reparseUserAgents field.
+func (r *mutationResolver) ReparseUserAgents(ctx context.Context) (int, error) {
+	if !server.IsAdmin(ctx) {
+		return 0, errors.New("unauthorized")
+	}
+	return r.peerStore.ReparseUserAgents(ctx, r.uaParser)
+}
+
 // AggregateByAgentName is the resolver for the aggregateByAgentName field.
 func (r *queryResolver) AggregateByAgentName(ctx context.Context, peerFilter *model.PeerFilter) ([]*model.AggregateData, error) {
 	aggregateData, err := r.peerStore.AggregateByAgentName(ctx, peerFilter)
@@ -89,8 +105,9 @@ func (r *queryResolver) AggregateByHardforkSchedule(ctx context.Context, peerFil
 
 	result := []*model.NextHardforkAggregation{}
 
-	for _, group := range model.GroupByHardforkSchedule(allPeers) {
+	for _, group := range model.GroupByHardforkSchedule(allPeers, r.networks) {
 		result = append(result, &model.NextHardforkAggregation{
+			Name:    group.Name,
 			Version: group.Version,
 			Epoch:   group.Epoch,
 			Count:   group.Count,
@@ -124,6 +141,207 @@ func (r *queryResolver) AggregateByClientVersion(ctx context.Context, peerFilter
 	return result, nil
 }
 
+// AggregateByFailureReason is the resolver for the aggregateByFailureReason field.
+func (r *queryResolver) AggregateByFailureReason(ctx context.Context, peerFilter *model.PeerFilter) ([]*model.AggregateData, error) {
+	aggregateData, err := r.peerStore.AggregateByFailureReason(ctx, peerFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []*model.AggregateData{}
+	for i := range aggregateData {
+		result = append(result, &model.AggregateData{
+			Name:  aggregateData[i].Name,
+			Count: aggregateData[i].Count,
+		})
+	}
+	return result, nil
+}
+
+// AggregateByCapability is the resolver for the aggregateByCapability field.
+func (r *queryResolver) AggregateByCapability(ctx context.Context, peerFilter *model.PeerFilter) ([]*model.AggregateData, error) {
+	aggregateData, err := r.peerStore.AggregateByCapability(ctx, peerFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []*model.AggregateData{}
+	for i := range aggregateData {
+		result = append(result, &model.AggregateData{
+			Name:  aggregateData[i].Name,
+			Count: aggregateData[i].Count,
+		})
+	}
+	return result, nil
+}
+
+// AggregateByIPStack is the resolver for the aggregateByIPStack field.
+func (r *queryResolver) AggregateByIPStack(ctx context.Context, peerFilter *model.PeerFilter) ([]*model.AggregateData, error) {
+	aggregateData, err := r.peerStore.AggregateByIPStack(ctx, peerFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []*model.AggregateData{}
+	for i := range aggregateData {
+		result = append(result, &model.AggregateData{
+			Name:  aggregateData[i].Name,
+			Count: aggregateData[i].Count,
+		})
+	}
+	return result, nil
+}
+
+// AggregateByProtocol is the resolver for the aggregateByProtocol field.
+func (r *queryResolver) AggregateByProtocol(ctx context.Context, peerFilter *model.PeerFilter) ([]*model.AggregateData, error) {
+	aggregateData, err := r.peerStore.AggregateByProtocol(ctx, peerFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []*model.AggregateData{}
+	for i := range aggregateData {
+		result = append(result, &model.AggregateData{
+			Name:  aggregateData[i].Name,
+			Count: aggregateData[i].Count,
+		})
+	}
+	return result, nil
+}
+
+// AggregateByFinalizedCheckpoint is the resolver for the aggregateByFinalizedCheckpoint field.
+func (r *queryResolver) AggregateByFinalizedCheckpoint(ctx context.Context, windowEpochs *int, peerFilter *model.PeerFilter) ([]*model.CheckpointAggregation, error) {
+	aggregateData, err := r.peerStore.AggregateByFinalizedCheckpoint(ctx, r.windowStart(windowEpochs, peerFilter), peerFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []*model.CheckpointAggregation{}
+	for i := range aggregateData {
+		result = append(result, &model.CheckpointAggregation{
+			Epoch:  strconv.FormatUint(aggregateData[i].Epoch, 10),
+			Root:   aggregateData[i].Root,
+			Count:  aggregateData[i].Count,
+			Behind: aggregateData[i].Behind,
+		})
+	}
+	return result, nil
+}
+
+// AggregateByHeadRoot is the resolver for the aggregateByHeadRoot field.
+func (r *queryResolver) AggregateByHeadRoot(ctx context.Context, windowEpochs *int, peerFilter *model.PeerFilter) ([]*model.HeadAggregation, error) {
+	aggregateData, err := r.peerStore.AggregateByHeadRoot(ctx, r.windowStart(windowEpochs, peerFilter), peerFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []*model.HeadAggregation{}
+	for i := range aggregateData {
+		result = append(result, &model.HeadAggregation{
+			Slot:  strconv.FormatUint(aggregateData[i].Slot, 10),
+			Root:  aggregateData[i].Root,
+			Count: aggregateData[i].Count,
+		})
+	}
+	return result, nil
+}
+
+// LatencyPercentiles is the resolver for the latencyPercentiles field.
+func (r *queryResolver) LatencyPercentiles(ctx context.Context, groupBy model.LatencyGroup, peerFilter *model.PeerFilter) ([]*model.LatencyPercentiles, error) {
+	percentiles, err := r.peerStore.LatencyPercentiles(ctx, groupBy, peerFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []*model.LatencyPercentiles{}
+	for i := range percentiles {
+		result = append(result, &model.LatencyPercentiles{
+			Name:  percentiles[i].Name,
+			Count: percentiles[i].Count,
+			P50:   float64(percentiles[i].P50) / 1000,
+			P90:   float64(percentiles[i].P90) / 1000,
+			P99:   float64(percentiles[i].P99) / 1000,
+		})
+	}
+	return result, nil
+}
+
+// AggregateBySecurity is the resolver for the aggregateBySecurity field.
+func (r *queryResolver) AggregateBySecurity(ctx context.Context, peerFilter *model.PeerFilter) ([]*model.AggregateData, error) {
+	aggregateData, err := r.peerStore.AggregateBySecurity(ctx, peerFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []*model.AggregateData{}
+	for i := range aggregateData {
+		result = append(result, &model.AggregateData{
+			Name:  aggregateData[i].Name,
+			Count: aggregateData[i].Count,
+		})
+	}
+	return result, nil
+}
+
+// AggregateByMuxer is the resolver for the aggregateByMuxer field.
+func (r *queryResolver) AggregateByMuxer(ctx context.Context, peerFilter *model.PeerFilter) ([]*model.AggregateData, error) {
+	aggregateData, err := r.peerStore.AggregateByMuxer(ctx, peerFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []*model.AggregateData{}
+	for i := range aggregateData {
+		result = append(result, &model.AggregateData{
+			Name:  aggregateData[i].Name,
+			Count: aggregateData[i].Count,
+		})
+	}
+	return result, nil
+}
+
+// AggregateBySyncState is the resolver for the aggregateBySyncState field.
+func (r *queryResolver) AggregateBySyncState(ctx context.Context, peerFilter *model.PeerFilter) ([]*model.AggregateData, error) {
+	aggregateData, err := r.peerStore.AggregateBySyncState(ctx, peerFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []*model.AggregateData{}
+	for i := range aggregateData {
+		result = append(result, &model.AggregateData{
+			Name:  aggregateData[i].Name,
+			Count: aggregateData[i].Count,
+		})
+	}
+	return result, nil
+}
+
+// SyncLagHistogram is the resolver for the syncLagHistogram field.
+func (r *queryResolver) SyncLagHistogram(ctx context.Context, peerFilter *model.PeerFilter) ([]*model.ClientSyncLag, error) {
+	histograms, err := r.peerStore.SyncLagByClient(ctx, peerFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []*model.ClientSyncLag{}
+	for i := range histograms {
+		buckets := []*model.SyncLagBucket{}
+		for _, bucket := range histograms[i].Buckets {
+			buckets = append(buckets, &model.SyncLagBucket{
+				Le:    bucket.UpperBound,
+				Count: bucket.Count,
+			})
+		}
+		result = append(result, &model.ClientSyncLag{
+			Client:  histograms[i].Client,
+			Count:   histograms[i].Count,
+			Buckets: buckets,
+		})
+	}
+	return result, nil
+}
+
 // GetHeatmapData is the resolver for the getHeatmapData field.
 func (r *queryResolver) GetHeatmapData(ctx context.Context, peerFilter *model.PeerFilter) ([]*model.HeatmapData, error) {
 	peers, err := r.peerStore.ViewAll(ctx, peerFilter)
@@ -217,26 +435,114 @@ func (r *queryResolver) GetRegionalStats(ctx context.Context, peerFilter *model.
 
 // GetAltairUpgradePercentage is the resolver for the getAltairUpgradePercentage field.
 func (r *queryResolver) GetAltairUpgradePercentage(ctx context.Context, peerFilter *model.PeerFilter) (float64, error) {
-	aggregateData, err := r.peerStore.AggregateByClientVersion(ctx, peerFilter)
+	// the deprecated query keeps answering without a network defining altair
+	if !slices.ContainsFunc(r.networks, func(n *svcModels.Network) bool { return n.HasFork("altair") }) {
+		return 0, nil
+	}
+	readiness, err := r.ForkReadiness(ctx, "altair", peerFilter)
 	if err != nil {
 		return 0, err
 	}
-	// check altair upgrade
+	// the percentage is checked from the client versions only
 	count := 0
 	total := 0
-	for _, client := range aggregateData {
-		for _, v := range client.Versions {
-			total += v.Count
-			if model.SupportAltairUpgrade(client.Client, v.Name) {
-				count += v.Count
-			}
+	for _, network := range readiness {
+		count += network.ReadyByVersion
+		total += network.Total
+	}
+	if total == 0 {
+		return 0, nil
+	}
+	return float64(count) / float64(total) * 100, nil
+}
+
+// ForkReadiness is the resolver for the forkReadiness field.
+func (r *queryResolver) ForkReadiness(ctx context.Context, fork string, peerFilter *model.PeerFilter) ([]*model.ForkReadiness, error) {
+	var networks svcModels.Networks
+	for _, network := range r.networks {
+		if network.HasFork(fork) {
+			networks = append(networks, network)
+		}
+	}
+	if len(networks) == 0 {
+		return nil, fmt.Errorf("unknown fork %s", fork)
+	}
+	result := []*model.ForkReadiness{}
+	err := r.forEachNetwork(ctx, networks, peerFilter, func(network *svcModels.Network, peers []*svcModels.Peer) error {
+		readiness, err := svcModels.NewForkReadiness(network, fork, r.forkRequirements.Find(network.Name, fork), peers)
+		if err != nil {
+			return err
+		}
+		result = append(result, model.NewForkReadiness(readiness))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// MisconfiguredPeers is the resolver for the misconfiguredPeers field.
+func (r *queryResolver) MisconfiguredPeers(ctx context.Context, peerFilter *model.PeerFilter) ([]*model.MisconfiguredPeer, error) {
+	checks, err := r.scheduleChecks(ctx, peerFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []*model.MisconfiguredPeer{}
+	for _, check := range checks {
+		if check.Status != svcModels.ScheduleCorrect {
+			result = append(result, model.NewMisconfiguredPeer(check))
 		}
 	}
-	percentage := float64(count) / float64(total) * 100
-	return percentage, nil
+	return result, nil
 }
 
+// AggregateMisconfigured is the resolver for the aggregateMisconfigured field.
+func (r *queryResolver) AggregateMisconfigured(ctx context.Context, peerFilter *model.PeerFilter) ([]*model.MisconfigurationAggregation, error) {
+	checks, err := r.scheduleChecks(ctx, peerFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []*model.MisconfigurationAggregation{}
+	for _, group := range svcModels.AggregateMisconfigured(checks) {
+		result = append(result, &model.MisconfigurationAggregation{
+			Client:  string(group.Client),
+			Version: group.Version,
+			Status:  model.NewScheduleStatus(group.Status),
+			Count:   group.Count,
+		})
+	}
+	return result, nil
+}
+
+// GetPeer is the resolver for the getPeer field.
+func (r *queryResolver) GetPeer(ctx context.Context, id string) (*model.Peer, error) {
+	peerID, err := peer.Decode(id)
+	if err != nil {
+		return nil, err
+	}
+	p, err := r.peerStore.View(ctx, peerID)
+	if err != nil {
+		if errors.Is(err, peerstore.ErrPeerNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return model.NewPeer(p), nil
+}
+
+// DecodeEnr is the resolver for the decodeEnr field.
+func (r *queryResolver) DecodeEnr(ctx context.Context, enr string) (*model.DecodedEnr, error) {
+	return model.DecodeEnr(enr)
+}
+
+// Mutation returns generated.MutationResolver implementation.
+func (r *Resolver) Mutation() generated.MutationResolver { return &mutationResolver{r} }
+
 // Query returns generated.QueryResolver implementation.
 func (r *Resolver) Query() generated.QueryResolver { return &queryResolver{r} }
 
+type mutationResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
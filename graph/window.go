@@ -0,0 +1,32 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package graph
+
+import (
+	"time"
+
+	"eth2-crawler/graph/model"
+)
+
+// defaultWindowEpochs is the number of epochs of the chain views without a window
+const defaultWindowEpochs = 4
+
+// windowStart returns the unix time of the first status of the chain views, windowEpochs epochs
+// ago on the filtered network, or on the network with the longest epochs without one
+func (r *Resolver) windowStart(windowEpochs *int, peerFilter *model.PeerFilter) int64 {
+	epochs := defaultWindowEpochs
+	if windowEpochs != nil && *windowEpochs > 0 {
+		epochs = *windowEpochs
+	}
+	var epoch time.Duration
+	for _, network := range r.networks {
+		if peerFilter != nil && peerFilter.Network != nil && *peerFilter.Network != network.Name {
+			continue
+		}
+		if d := network.EpochDuration(); d > epoch {
+			epoch = d
+		}
+	}
+	return time.Now().Add(-time.Duration(epochs) * epoch).Unix()
+}
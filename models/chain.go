@@ -0,0 +1,52 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package models
+
+import (
+	"time"
+
+	"github.com/protolambda/zrnt/eth2/beacon/common"
+)
+
+// ChainStatus holds the chain view of a peer from its last status response
+type ChainStatus struct {
+	ForkDigest     common.ForkDigest `json:"fork_digest" bson:"fork_digest"` // may differ from the record one around the forks
+	FinalizedRoot  string            `json:"finalized_root" bson:"finalized_root"`
+	FinalizedEpoch uint64            `json:"finalized_epoch" bson:"finalized_epoch"`
+	HeadRoot       string            `json:"head_root" bson:"head_root"`
+	HeadSlot       uint64            `json:"head_slot" bson:"head_slot"`
+	Epoch          uint64            `json:"epoch" bson:"epoch"` // current epoch of the network when the status was received
+	UpdatedAt      int64             `json:"updated_at" bson:"updated_at"`
+}
+
+// MaxFinalityDelay is the number of epochs the finalized checkpoint normally trails the current epoch by
+const MaxFinalityDelay = 3
+
+// NewChainStatus returns the chain view of a status response received at epoch
+func NewChainStatus(status *common.Status, epoch common.Epoch) *ChainStatus {
+	return &ChainStatus{
+		ForkDigest:     status.ForkDigest,
+		FinalizedRoot:  status.FinalizedRoot.String(),
+		FinalizedEpoch: uint64(status.FinalizedEpoch),
+		HeadRoot:       status.HeadRoot.String(),
+		HeadSlot:       uint64(status.HeadSlot),
+		Epoch:          uint64(epoch),
+		UpdatedAt:      time.Now().Unix(),
+	}
+}
+
+// CheckpointAggregation counts the peers on a finalized checkpoint
+type CheckpointAggregation struct {
+	Epoch  uint64
+	Root   string
+	Count  int
+	Behind int // peers finalizing more than MaxFinalityDelay epochs behind their status epoch
+}
+
+// HeadAggregation counts the peers on a head block
+type HeadAggregation struct {
+	Slot  uint64
+	Root  string
+	Count int
+}
@@ -0,0 +1,27 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package models
+
+import (
+	"testing"
+
+	"github.com/protolambda/zrnt/eth2/beacon/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewChainStatus(t *testing.T) {
+	status := NewChainStatus(&common.Status{
+		ForkDigest:     common.ForkDigest{0x6a, 0x95, 0xa1, 0xa9},
+		FinalizedRoot:  common.Root{0x01},
+		FinalizedEpoch: 100,
+		HeadRoot:       common.Root{0x02},
+		HeadSlot:       3210,
+	}, 102)
+	assert.Equal(t, common.ForkDigest{0x6a, 0x95, 0xa1, 0xa9}, status.ForkDigest)
+	assert.Equal(t, "0x0100000000000000000000000000000000000000000000000000000000000000", status.FinalizedRoot)
+	assert.Equal(t, uint64(100), status.FinalizedEpoch)
+	assert.Equal(t, "0x0200000000000000000000000000000000000000000000000000000000000000", status.HeadRoot)
+	assert.Equal(t, uint64(3210), status.HeadSlot)
+	assert.Equal(t, uint64(102), status.Epoch)
+}
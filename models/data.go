@@ -8,6 +8,16 @@ const (
 	SyncTypeUnsynced = "unsynced"
 )
 
+// IP stacks of the peers endpoints
+const (
+	IPStackIPv4 = "ipv4"
+	IPStackIPv6 = "ipv6"
+	IPStackDual = "dual"
+)
+
+// Capabilities are the optional node record keys counted by the capability aggregation
+var Capabilities = []string{"attnets", "syncnets", "cgc", "nfd", "quic", "quic6", "ip6", "tcp6", "udp6"}
+
 // AggregateData represents data of group by queries
 type AggregateData struct {
 	Name  string `json:"name"`
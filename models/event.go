@@ -0,0 +1,45 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+// EventType defines the type of a peer event
+type EventType string
+
+const (
+	EventRecordUpdated EventType = "record_updated"
+)
+
+// Change holds the old and new value of a peer field
+type Change struct {
+	Field string `json:"field" bson:"field"`
+	Old   string `json:"old" bson:"old"`
+	New   string `json:"new" bson:"new"`
+}
+
+// Event holds a change of a peer
+type Event struct {
+	ID      uuid.UUID `json:"id" bson:"_id"`
+	PeerID  peer.ID   `json:"peer_id" bson:"peer_id"`
+	Type    EventType `json:"type" bson:"type"`
+	Time    int64     `json:"time" bson:"time"`
+	Changes []*Change `json:"changes" bson:"changes"`
+}
+
+// NewEvent initializes a new event of the peer
+func NewEvent(peerID peer.ID, eventType EventType, changes []*Change) *Event {
+	return &Event{
+		ID:      uuid.New(),
+		PeerID:  peerID,
+		Type:    eventType,
+		Time:    time.Now().Unix(),
+		Changes: changes,
+	}
+}
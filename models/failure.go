@@ -0,0 +1,27 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package models
+
+// FailureReason defines the step a probe of a peer failed at
+type FailureReason string
+
+const (
+	FailureUDPUnreachable         FailureReason = "udp_unreachable"
+	FailureDial                   FailureReason = "dial"
+	FailureSecurityHandshake      FailureReason = "security_handshake"
+	FailureMultistreamNegotiation FailureReason = "multistream_negotiation"
+	FailureStatusStreamReset      FailureReason = "status_stream_reset"
+	FailurePing                   FailureReason = "ping"
+	FailureIdentifyTimeout        FailureReason = "identify_timeout"
+	FailureForkDigestMismatch     FailureReason = "fork_digest_mismatch"
+	FailureTooManyPeers           FailureReason = "too_many_peers"
+	FailureUnknown                FailureReason = "unknown"
+)
+
+// Failure holds the last failed probe of a peer
+type Failure struct {
+	Reason FailureReason `json:"reason" bson:"reason"`
+	Error  string        `json:"error" bson:"error"`
+	Time   int64         `json:"time" bson:"time"`
+}
@@ -0,0 +1,54 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+// GoodbyeReason defines the reason code of a goodbye message
+type GoodbyeReason uint64
+
+const (
+	GoodbyeClientShutdown    GoodbyeReason = 1
+	GoodbyeIrrelevantNetwork GoodbyeReason = 2
+	GoodbyeFault             GoodbyeReason = 3
+	GoodbyeUnableToVerify    GoodbyeReason = 128
+	GoodbyeTooManyPeers      GoodbyeReason = 129
+	GoodbyeBadScore          GoodbyeReason = 250
+	GoodbyeBanned            GoodbyeReason = 251
+)
+
+var goodbyeReasons = map[GoodbyeReason]string{
+	GoodbyeClientShutdown:    "client shutdown",
+	GoodbyeIrrelevantNetwork: "irrelevant network",
+	GoodbyeFault:             "fault",
+	GoodbyeUnableToVerify:    "unable to verify network",
+	GoodbyeTooManyPeers:      "too many peers",
+	GoodbyeBadScore:          "bad score",
+	GoodbyeBanned:            "banned",
+}
+
+// String returns the reason name, client specific codes are returned as is
+func (r GoodbyeReason) String() string {
+	if name, ok := goodbyeReasons[r]; ok {
+		return name
+	}
+	return fmt.Sprintf("unknown (%d)", uint64(r))
+}
+
+// Goodbye holds the last goodbye message sent by a peer
+type Goodbye struct {
+	Reason GoodbyeReason `json:"reason" bson:"reason"`
+	Time   int64         `json:"time" bson:"time"`
+}
+
+// NewGoodbye initializes a goodbye received now
+func NewGoodbye(reason GoodbyeReason) *Goodbye {
+	return &Goodbye{
+		Reason: reason,
+		Time:   time.Now().Unix(),
+	}
+}
@@ -0,0 +1,13 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package models
+
+// Identify holds the details a peer sends with the libp2p identify protocol
+type Identify struct {
+	ProtocolVersion string
+	AgentVersion    string
+	Protocols       []string // ids of the protocols the peer supports
+	ListenAddrs     []string
+	ObservedAddr    string // address of the crawler as seen by the peer
+}
@@ -0,0 +1,76 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package models
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+// latencyWindow is the number of recent round trip times kept for a peer
+const latencyWindow = 10
+
+// Latency is the rolling summary of the round trip times measured to a peer, in microseconds
+// as the peers close to the crawler answer within a millisecond
+type Latency struct {
+	Last       int64   `json:"last" bson:"last"`
+	Min        int64   `json:"min" bson:"min"`
+	Max        int64   `json:"max" bson:"max"`
+	Median     int64   `json:"median" bson:"median"`   // median of the recent samples
+	Samples    []int64 `json:"samples" bson:"samples"` // recent samples, the oldest first
+	MeasuredAt int64   `json:"measured_at" bson:"measured_at"`
+}
+
+// Add adds a round trip time to the summary, the oldest sample leaves the window when it is full
+func (l *Latency) Add(rtt time.Duration) {
+	us := rtt.Microseconds()
+	if len(l.Samples) == 0 || us < l.Min {
+		l.Min = us
+	}
+	if us > l.Max {
+		l.Max = us
+	}
+	l.Last = us
+	l.Samples = append(l.Samples, us)
+	if len(l.Samples) > latencyWindow {
+		l.Samples = l.Samples[len(l.Samples)-latencyWindow:]
+	}
+	l.Median = Percentile(l.Samples, 50)
+	l.MeasuredAt = time.Now().Unix()
+}
+
+// LatencyPercentiles holds the percentiles of the latencies of a group of peers, in microseconds
+type LatencyPercentiles struct {
+	Name  string
+	Count int
+	P50   int64
+	P90   int64
+	P99   int64
+}
+
+// NewLatencyPercentiles computes the percentiles of the group latencies
+func NewLatencyPercentiles(name string, latencies []int64) *LatencyPercentiles {
+	return &LatencyPercentiles{
+		Name:  name,
+		Count: len(latencies),
+		P50:   Percentile(latencies, 50),
+		P90:   Percentile(latencies, 90),
+		P99:   Percentile(latencies, 99),
+	}
+}
+
+// Percentile returns the nearest-rank percentile of the values, 0 when there are none
+func Percentile(values []int64, percentile float64) int64 {
+	if len(values) == 0 {
+		return 0
+	}
+	sorted := append([]int64{}, values...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	rank := int(math.Ceil(percentile / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
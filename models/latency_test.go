@@ -0,0 +1,44 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLatencyAdd(t *testing.T) {
+	latency := new(Latency)
+	for _, rtt := range []int64{40, 20, 30} {
+		latency.Add(time.Duration(rtt) * time.Millisecond)
+	}
+	assert.Equal(t, int64(30000), latency.Last)
+	assert.Equal(t, int64(20000), latency.Min)
+	assert.Equal(t, int64(40000), latency.Max)
+	assert.Equal(t, int64(30000), latency.Median)
+
+	for i := 0; i < latencyWindow; i++ {
+		latency.Add(100 * time.Millisecond)
+	}
+	assert.Len(t, latency.Samples, latencyWindow)
+	assert.Equal(t, int64(100000), latency.Median)
+	assert.Equal(t, int64(20000), latency.Min)
+
+	// the local peers answer within a millisecond
+	latency = new(Latency)
+	latency.Add(450 * time.Microsecond)
+	assert.Equal(t, int64(450), latency.Median)
+}
+
+func TestNewLatencyPercentiles(t *testing.T) {
+	latencies := make([]int64, 0, 100)
+	for i := int64(100); i > 0; i-- {
+		latencies = append(latencies, i)
+	}
+	assert.Equal(t, &LatencyPercentiles{Name: "Germany", Count: 100, P50: 50, P90: 90, P99: 99},
+		NewLatencyPercentiles("Germany", latencies))
+	assert.Equal(t, &LatencyPercentiles{Name: "France"}, NewLatencyPercentiles("France", nil))
+}
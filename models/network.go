@@ -0,0 +1,251 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package models
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	"eth2-crawler/crawler/util"
+	"eth2-crawler/utils/config"
+
+	"github.com/protolambda/zrnt/eth2/beacon/common"
+)
+
+// Fork represents an entry of the network fork schedule
+type Fork struct {
+	Name    string
+	Version common.Version
+	Epoch   common.Epoch
+}
+
+// blobParametersFork is the fork from which the fork digests commit to the blob parameters
+const blobParametersFork = "fulu"
+
+// BlobParameters represents an entry of the network blob schedule
+type BlobParameters struct {
+	Epoch            common.Epoch
+	MaxBlobsPerBlock uint64
+}
+
+// ForkDigest is the digest of a fork, or of a blob schedule change, of the network
+type ForkDigest struct {
+	Name    string
+	Version common.Version
+	Epoch   common.Epoch
+	Digest  common.ForkDigest
+}
+
+// Network holds the chain configuration of a crawled eth2 network
+type Network struct {
+	Name                  string
+	Bootnodes             []string
+	GenesisTime           time.Time
+	GenesisValidatorsRoot common.Root
+	SecondsPerSlot        uint64
+	SlotsPerEpoch         uint64
+	Forks                 []*Fork
+	BlobSchedule          []*BlobParameters
+	Digests               []*ForkDigest
+}
+
+// NewNetwork initializes network from its config
+func NewNetwork(cfg *config.Network) (*Network, error) {
+	var root common.Root
+	if err := root.UnmarshalText([]byte(cfg.GenesisValidatorsRoot)); err != nil {
+		return nil, fmt.Errorf("invalid genesis validators root: %w", err)
+	}
+	forks := make([]*Fork, 0, len(cfg.Forks))
+	for _, f := range cfg.Forks {
+		var version common.Version
+		if err := version.UnmarshalText([]byte(f.Version)); err != nil {
+			return nil, fmt.Errorf("invalid version of fork %s: %w", f.Name, err)
+		}
+		forks = append(forks, &Fork{
+			Name:    f.Name,
+			Version: version,
+			Epoch:   common.Epoch(f.Epoch),
+		})
+	}
+	schedule := make([]*BlobParameters, 0, len(cfg.BlobSchedule))
+	for _, b := range cfg.BlobSchedule {
+		if len(schedule) > 0 && common.Epoch(b.Epoch) <= schedule[len(schedule)-1].Epoch {
+			return nil, fmt.Errorf("blob schedule epochs must increase, epoch %d", b.Epoch)
+		}
+		schedule = append(schedule, &BlobParameters{
+			Epoch:            common.Epoch(b.Epoch),
+			MaxBlobsPerBlock: b.MaxBlobsPerBlock,
+		})
+	}
+	network := &Network{
+		Name:                  cfg.Name,
+		Bootnodes:             cfg.Bootnodes,
+		GenesisTime:           time.Unix(cfg.GenesisTime, 0),
+		GenesisValidatorsRoot: root,
+		SecondsPerSlot:        cfg.SecondsPerSlot,
+		SlotsPerEpoch:         cfg.SlotsPerEpoch,
+		Forks:                 forks,
+		BlobSchedule:          schedule,
+	}
+	digests, err := network.computeDigests()
+	if err != nil {
+		return nil, err
+	}
+	network.Digests = digests
+	return network, nil
+}
+
+// computeDigests computes the digests of the fork schedule. From fulu on, every change
+// of the blob parameters gets its own digest, named after its rank in the schedule
+func (n *Network) computeDigests() ([]*ForkDigest, error) {
+	digests := make([]*ForkDigest, 0, len(n.Forks))
+	withBlobs := false
+	bpo := 0
+	for i, f := range n.Forks {
+		withBlobs = withBlobs || f.Name == blobParametersFork
+		digest := common.ComputeForkDigest(f.Version, n.GenesisValidatorsRoot)
+		if !withBlobs {
+			digests = append(digests, &ForkDigest{Name: f.Name, Version: f.Version, Epoch: f.Epoch, Digest: digest})
+			continue
+		}
+		params := n.blobParameters(f.Epoch)
+		if params == nil {
+			return nil, fmt.Errorf("no blob parameters at the epoch %d of fork %s", f.Epoch, f.Name)
+		}
+		digests = append(digests, &ForkDigest{Name: f.Name, Version: f.Version, Epoch: f.Epoch, Digest: maskDigest(digest, params)})
+		for _, params := range n.BlobSchedule {
+			if params.Epoch <= f.Epoch || (i+1 < len(n.Forks) && params.Epoch >= n.Forks[i+1].Epoch) {
+				continue
+			}
+			bpo++
+			digests = append(digests, &ForkDigest{
+				Name:    fmt.Sprintf("bpo%d", bpo),
+				Version: f.Version,
+				Epoch:   params.Epoch,
+				Digest:  maskDigest(digest, params),
+			})
+		}
+	}
+	return digests, nil
+}
+
+// blobParameters returns the blob parameters in effect at epoch or nil
+func (n *Network) blobParameters(epoch common.Epoch) *BlobParameters {
+	var params *BlobParameters
+	for _, p := range n.BlobSchedule {
+		if p.Epoch <= epoch {
+			params = p
+		}
+	}
+	return params
+}
+
+// maskDigest mixes the blob parameters into the fork digest
+func maskDigest(digest common.ForkDigest, params *BlobParameters) common.ForkDigest {
+	var data [16]byte
+	binary.LittleEndian.PutUint64(data[:8], uint64(params.Epoch))
+	binary.LittleEndian.PutUint64(data[8:], params.MaxBlobsPerBlock)
+	hash := sha256.Sum256(data[:])
+	for i := range digest {
+		digest[i] ^= hash[i]
+	}
+	return digest
+}
+
+// ForkName returns the name of the fork of digest, or an empty string for unknown digests
+func (n *Network) ForkName(digest common.ForkDigest) string {
+	for _, d := range n.Digests {
+		if d.Digest == digest {
+			return d.Name
+		}
+	}
+	return ""
+}
+
+// NextForkName returns the name of the fork the node record announces. The blob schedule
+// changes keep the fork version, they are told apart by their epoch
+func (n *Network) NextForkName(version common.Version, epoch common.Epoch) string {
+	name := ""
+	for _, d := range n.Digests {
+		if d.Version != version {
+			continue
+		}
+		if d.Epoch == epoch {
+			return d.Name
+		}
+		if name == "" {
+			name = d.Name
+		}
+	}
+	return name
+}
+
+// CurrentSlot returns the current slot of the network
+func (n *Network) CurrentSlot() int64 {
+	return util.CurrentSlot(n.GenesisTime, n.SecondsPerSlot)
+}
+
+// EpochDuration returns the duration of an epoch of the network
+func (n *Network) EpochDuration() time.Duration {
+	return time.Duration(n.SecondsPerSlot*n.SlotsPerEpoch) * time.Second
+}
+
+// HasForkDigest checks if digest is one of the network fork digests
+func (n *Network) HasForkDigest(digest common.ForkDigest) bool {
+	return n.ForkName(digest) != ""
+}
+
+// Networks holds all the crawled networks
+type Networks []*Network
+
+// NewNetworks initializes all the networks from config
+func NewNetworks(cfgs []*config.Network) (Networks, error) {
+	networks := make(Networks, 0, len(cfgs))
+	for _, cfg := range cfgs {
+		network, err := NewNetwork(cfg)
+		if err != nil {
+			return nil, fmt.Errorf("invalid network %s: %w", cfg.Name, err)
+		}
+		networks = append(networks, network)
+	}
+	return networks, nil
+}
+
+// ByName returns the network with given name or nil
+func (n Networks) ByName(name string) *Network {
+	for _, network := range n {
+		if network.Name == name {
+			return network
+		}
+	}
+	return nil
+}
+
+// Match returns the network the eth2 data belongs to or nil. The fork digests commit to
+// the genesis validators root, devnets and shadow forks reusing the fork versions differ
+func (n Networks) Match(eth2Data *common.Eth2Data) *Network {
+	for _, network := range n {
+		if network.HasForkDigest(eth2Data.ForkDigest) {
+			return network
+		}
+	}
+	return nil
+}
+
+// Bootnodes returns the bootnodes of all the networks
+func (n Networks) Bootnodes() []string {
+	seen := make(map[string]bool)
+	bootnodes := make([]string, 0)
+	for _, network := range n {
+		for _, bootnode := range network.Bootnodes {
+			if !seen[bootnode] {
+				seen[bootnode] = true
+				bootnodes = append(bootnodes, bootnode)
+			}
+		}
+	}
+	return bootnodes
+}
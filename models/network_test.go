@@ -0,0 +1,97 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package models
+
+import (
+	"testing"
+
+	"eth2-crawler/utils/config"
+
+	"github.com/protolambda/zrnt/eth2/beacon/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mainnetConfig() *config.Network {
+	return &config.Network{
+		Name:                  "mainnet",
+		GenesisTime:           1606824023,
+		GenesisValidatorsRoot: "0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95",
+		SecondsPerSlot:        12,
+		SlotsPerEpoch:         32,
+		Forks: []*config.Fork{
+			{Name: "phase0", Version: "0x00000000", Epoch: 0},
+			{Name: "altair", Version: "0x01000000", Epoch: 74240},
+			{Name: "bellatrix", Version: "0x02000000", Epoch: 144896},
+			{Name: "capella", Version: "0x03000000", Epoch: 194048},
+			{Name: "deneb", Version: "0x04000000", Epoch: 269568},
+			{Name: "electra", Version: "0x05000000", Epoch: 364032},
+			{Name: "fulu", Version: "0x06000000", Epoch: 411392},
+		},
+		BlobSchedule: []*config.BlobParameter{
+			{Epoch: 364032, MaxBlobsPerBlock: 9},
+			{Epoch: 412672, MaxBlobsPerBlock: 15},
+			{Epoch: 419072, MaxBlobsPerBlock: 21},
+		},
+	}
+}
+
+func TestNetworkDigests(t *testing.T) {
+	network, err := NewNetwork(mainnetConfig())
+	require.NoError(t, err)
+
+	names := make([]string, 0)
+	digests := make(map[string]string)
+	for _, d := range network.Digests {
+		names = append(names, d.Name)
+		digests[d.Name] = d.Digest.String()
+	}
+	assert.Equal(t, []string{"phase0", "altair", "bellatrix", "capella", "deneb", "electra", "fulu", "bpo1", "bpo2"}, names)
+	assert.Equal(t, "0xb5303f2a", digests["phase0"])
+	assert.Equal(t, "0xafcaaba0", digests["altair"])
+	assert.Equal(t, "0x4a26c58b", digests["bellatrix"])
+	assert.Equal(t, "0xbba4da96", digests["capella"])
+	assert.Equal(t, "0x6a95a1a9", digests["deneb"])
+	assert.Equal(t, "0xad532ceb", digests["electra"])
+
+	// the blob schedule changes keep the fulu version with their own digest
+	fulu := common.ComputeForkDigest(common.Version{0x06}, network.GenesisValidatorsRoot)
+	assert.NotEqual(t, fulu.String(), digests["fulu"])
+	assert.NotEqual(t, digests["fulu"], digests["bpo1"])
+	assert.NotEqual(t, digests["bpo1"], digests["bpo2"])
+
+	var digest common.ForkDigest
+	require.NoError(t, digest.UnmarshalText([]byte("0xbba4da96")))
+	assert.Equal(t, "capella", network.ForkName(digest))
+	assert.Equal(t, "", network.ForkName(common.ForkDigest{}))
+	assert.Equal(t, "bpo1", network.NextForkName(common.Version{0x06}, 412672))
+	assert.Equal(t, "fulu", network.NextForkName(common.Version{0x06}, 411392))
+	assert.Equal(t, "deneb", network.NextForkName(common.Version{0x04}, common.Epoch(^uint64(0))))
+}
+
+func TestNetworksMatch(t *testing.T) {
+	network, err := NewNetwork(mainnetConfig())
+	require.NoError(t, err)
+	networks := Networks{network}
+
+	var digest common.ForkDigest
+	require.NoError(t, digest.UnmarshalText([]byte("0xbba4da96")))
+	assert.Equal(t, network, networks.Match(&common.Eth2Data{ForkDigest: digest, NextForkVersion: common.Version{0x04}}))
+
+	// a shadow fork keeps the fork versions with another genesis validators root
+	shadow := common.ComputeForkDigest(common.Version{0x03}, common.Root{0x01})
+	assert.Nil(t, networks.Match(&common.Eth2Data{ForkDigest: shadow, NextForkVersion: common.Version{0x04}}))
+}
+
+func TestNetworkDigestsNeedBlobSchedule(t *testing.T) {
+	cfg := mainnetConfig()
+	cfg.BlobSchedule = nil
+	_, err := NewNetwork(cfg)
+	assert.Error(t, err)
+
+	cfg = mainnetConfig()
+	cfg.GenesisValidatorsRoot = "0x4b36"
+	_, err = NewNetwork(cfg)
+	assert.Error(t, err)
+}
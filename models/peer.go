@@ -7,22 +7,23 @@ package models
 import (
 	"encoding/hex"
 	"encoding/json"
+	"errors"
+	"net"
+	"strconv"
 	"strings"
 	"time"
 
 	"eth2-crawler/crawler/util"
 
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/p2p/enode"
-	ic "github.com/libp2p/go-libp2p-core/crypto"
-	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/libp2p/go-libp2p/core/peer"
 	ma "github.com/multiformats/go-multiaddr"
 	"github.com/protolambda/zrnt/eth2/beacon/common"
 )
 
-// 256 epochs
-const blockIgnoreThreshold = 8192
-
 // ClientName defines the type for eth2 client name
 type ClientName string
 
@@ -38,22 +39,6 @@ const (
 	OthersClient     ClientName = "others"
 )
 
-// clients contains mapping for client name - possible identity names mapping
-var clients map[ClientName][]string
-
-func init() {
-	clients = map[ClientName][]string{
-		PrysmClient:      {"prysm"},
-		LighthouseClient: {"lighthouse"},
-		TekuClient:       {"teku"},
-		CortexClient:     {"cortex"},
-		LodestarClient:   {"lodestar", "js-libp2p"},
-		NimbusClient:     {"nimbus"},
-		TrinityClient:    {"trinity"},
-		GrandineClient:   {"grandine", "rust-libp2p"},
-	}
-}
-
 // OS defines the type of os of agent
 
 type OS string
@@ -73,7 +58,10 @@ const (
 type UserAgent struct {
 	Name    ClientName `json:"name" bson:"name"`
 	Version string     `json:"version" bson:"version"`
+	Build   string     `json:"build,omitempty" bson:"build"` // pre-release or build metadata
+	Commit  string     `json:"commit,omitempty" bson:"commit"`
 	OS      OS         `json:"os" bson:"os"`
+	Arch    string     `json:"arch,omitempty" bson:"arch"`
 }
 
 // UsageType defines the ASN usage type
@@ -116,18 +104,21 @@ type GeoLocation struct {
 	Longitude float64 `json:"longitude" bson:"longitude"`
 }
 
-// Sync holds peer sync related info
-type Sync struct {
-	Status   bool `json:"status" bson:"status"`
-	Distance int  `json:"distance" bson:"distance"` // sync distance in percentage
+// MetaData holds the peer metadata from the req/resp MetaData method
+type MetaData struct {
+	Version           int               `json:"version" bson:"version"`
+	SeqNumber         uint64            `json:"seq_number" bson:"seq_number"`
+	Attnets           common.AttnetBits `json:"attnets" bson:"attnets"`
+	Syncnets          util.SyncnetBits  `json:"syncnets" bson:"syncnets"`
+	CustodyGroupCount uint64            `json:"custody_group_count" bson:"custody_group_count"` // only served by metadata v3
+	UpdatedAt         int64             `json:"updated_at" bson:"updated_at"`
 }
 
-// String returns the sync status
-func (s *Sync) String() string {
-	if s.Status {
-		return StatusSynced
-	}
-	return StatusUnsynced
+// UDPLiveness holds the result of the last discv5 ping to the peer
+type UDPLiveness struct {
+	Reachable bool  `json:"reachable" bson:"reachable"`
+	RTT       int64 `json:"rtt" bson:"rtt"` // round trip time in milliseconds
+	CheckedAt int64 `json:"checked_at" bson:"checked_at"`
 }
 
 // Peer holds all information of an eth2 peer
@@ -136,34 +127,73 @@ type Peer struct {
 	NodeID string  `json:"node_id" bson:"node_id"`
 	Pubkey string  `json:"pubkey" bson:"pubkey"`
 
-	IP      string   `json:"ip" bson:"ip"`
-	TCPPort int      `json:"tcp_port" bson:"tcp_port"`
-	UDPPort int      `json:"udp_port" bson:"udp_port"`
-	Addrs   []string `json:"addrs,omitempty" bson:"addrs"`
-
-	Attnets common.AttnetBits `json:"enr_attnets,omitempty" bson:"attnets"`
+	Network string `json:"network" bson:"network"`
+	Seq     uint64 `json:"seq" bson:"seq"` // sequence number of the node record
+	ENR     string `json:"enr" bson:"enr"`
+
+	IP        string   `json:"ip" bson:"ip"`
+	TCPPort   int      `json:"tcp_port" bson:"tcp_port"`
+	UDPPort   int      `json:"udp_port" bson:"udp_port"`
+	QUICPort  int      `json:"quic_port,omitempty" bson:"quic_port"`
+	IP6       string   `json:"ip6,omitempty" bson:"ip6"`
+	TCP6Port  int      `json:"tcp6_port,omitempty" bson:"tcp6_port"`
+	UDP6Port  int      `json:"udp6_port,omitempty" bson:"udp6_port"`
+	QUIC6Port int      `json:"quic6_port,omitempty" bson:"quic6_port"`
+	Addrs     []string `json:"addrs,omitempty" bson:"addrs"`
+	ENRKeys   []string `json:"enr_keys,omitempty" bson:"enr_keys"`
+
+	Attnets           common.AttnetBits `json:"enr_attnets,omitempty" bson:"attnets"`
+	Syncnets          util.SyncnetBits  `json:"enr_syncnets,omitempty" bson:"syncnets"`
+	CustodyGroupCount uint64            `json:"enr_custody_group_count,omitempty" bson:"custody_group_count"`
+	MetaData          *MetaData         `json:"metadata,omitempty" bson:"metadata"`
 
 	ForkDigest      common.ForkDigest `json:"fork_digest" bson:"fork_digest"`
 	ForkDigestStr   string            `json:"fork_digest_str" bson:"fork_digest_str"`
+	ForkName        string            `json:"fork_name,omitempty" bson:"fork_name"` // empty when the digest is not in the network schedule
 	NextForkEpoch   Epoch             `json:"next_fork_epoch" bson:"next_fork_epoch"`
 	NextForkVersion common.Version    `json:"next_fork_version" bson:"next_fork_version"`
+	NextForkDigest  common.ForkDigest `json:"next_fork_digest,omitempty" bson:"next_fork_digest"` // from the nfd entry, zero without it
 
 	ProtocolVersion string       `json:"protocol_version,omitempty" bson:"protocol_version"`
+	Protocols       []string     `json:"protocols,omitempty" bson:"protocols"`
+	ListenAddrs     []string     `json:"listen_addrs,omitempty" bson:"listen_addrs"`
+	ObservedAddr    string       `json:"observed_addr,omitempty" bson:"observed_addr"` // crawler address as seen by the peer
 	UserAgent       *UserAgent   `json:"user_agent,omitempty" bson:"user_agent"`
 	UserAgentRaw    string       `json:"user_agent_raw" bson:"user_agent_raw"`
-	GeoLocation     *GeoLocation `json:"geo_location" bson:"geo_location"`
-
-	Sync  *Sync `json:"sync" bson:"sync"`
-	Score Score `json:"score" bson:"score"`
-
-	IsConnectable bool  `json:"is_connectable" bson:"is_connectable"`
-	LastConnected int64 `json:"last_connected" bson:"last_connected"`
-	LastUpdated   int64 `json:"last_updated" bson:"last_updated"`
+	GeoLocation     *GeoLocation `json:"geo_location" bson:"geo_location"` // location of the ipv4 address, or the ipv6 one without it
+	GeoLocation6    *GeoLocation `json:"geo_location6,omitempty" bson:"geo_location6"`
+
+	Sync    *Sync        `json:"sync" bson:"sync"`
+	Chain   *ChainStatus `json:"chain,omitempty" bson:"chain"`
+	UDP     *UDPLiveness `json:"udp" bson:"udp"`
+	Latency *Latency     `json:"latency_us,omitempty" bson:"latency_us"`
+	Goodbye *Goodbye     `json:"goodbye,omitempty" bson:"goodbye"`
+	Score   Score        `json:"score" bson:"score"`
+
+	LastFailure   *Failure              `json:"last_failure,omitempty" bson:"last_failure"`
+	FailureCounts map[FailureReason]int `json:"failure_counts,omitempty" bson:"failure_counts"`
+
+	ProbeState     ProbeState `json:"probe_state" bson:"probe_state"`
+	FailedAttempts int        `json:"failed_attempts" bson:"failed_attempts"` // consecutive failed probes
+	BusyAttempts   int        `json:"busy_attempts" bson:"busy_attempts"`     // consecutive probes refused for too many peers
+	NextProbeAt    int64      `json:"next_probe_at" bson:"next_probe_at"`
+	ProbedSeq      uint64     `json:"probed_seq" bson:"probed_seq"` // sequence number of the record at the last full probe
+	ProbedAt       int64      `json:"probed_at" bson:"probed_at"`   // time of the last full probe
+
+	Transports    []string `json:"transports,omitempty" bson:"transports"` // transports of the last successful connection
+	Security      string   `json:"security,omitempty" bson:"security"`     // security protocol negotiated on the last connection
+	Muxer         string   `json:"muxer,omitempty" bson:"muxer"`           // stream muxer negotiated on the last connection
+	IsConnectable bool     `json:"is_connectable" bson:"is_connectable"`
+	LastConnected int64    `json:"last_connected" bson:"last_connected"`
+	LastUpdated   int64    `json:"last_updated" bson:"last_updated"`
 }
 
 // NewPeer initializes new peer
-func NewPeer(node *enode.Node, eth2Data *common.Eth2Data) (*Peer, error) {
-	pk := ic.PubKey((*ic.Secp256k1PublicKey)(node.Pubkey()))
+func NewPeer(node *enode.Node, eth2Data *common.Eth2Data, network string) (*Peer, error) {
+	pk, err := util.PubKeyFromNode(node)
+	if err != nil {
+		return nil, err
+	}
 	pkByte, err := pk.Raw()
 	if err != nil {
 		return nil, err
@@ -172,6 +202,9 @@ func NewPeer(node *enode.Node, eth2Data *common.Eth2Data) (*Peer, error) {
 	if err != nil {
 		return nil, err
 	}
+	if addr == nil {
+		return nil, errors.New("node has no tcp or quic endpoint")
+	}
 	addrStr := make([]string, 0)
 	for _, madd := range addr.Addrs {
 		addrStr = append(addrStr, madd.String())
@@ -182,11 +215,13 @@ func NewPeer(node *enode.Node, eth2Data *common.Eth2Data) (*Peer, error) {
 	if err == nil {
 		attnetsVal = *attnets
 	}
-	return &Peer{
+	p := &Peer{
 		ID:              addr.ID,
 		NodeID:          node.ID().String(),
 		Pubkey:          hex.EncodeToString(pkByte),
-		IP:              node.IP().String(),
+		Network:         network,
+		Seq:             node.Seq(),
+		ENR:             node.String(),
 		TCPPort:         node.TCP(),
 		UDPPort:         node.UDP(),
 		Addrs:           addrStr,
@@ -196,75 +231,132 @@ func NewPeer(node *enode.Node, eth2Data *common.Eth2Data) (*Peer, error) {
 		NextForkEpoch:   Epoch(eth2Data.NextForkEpoch),
 		Attnets:         attnetsVal,
 		Score:           ScoreGood,
-	}, nil
-}
-
-// SetProtocolVersion sets peer's protocol version
-func (p *Peer) SetProtocolVersion(pv string) {
-	p.ProtocolVersion = pv
+		ProbeState:      ProbeStateNew,
+	}
+	p.setRecordEntries(node)
+	return p, nil
 }
 
-// SetUserAgent sets peer's agent info
-func (p *Peer) SetUserAgent(ag string) {
-	// split the ag based on this format. might not be identical with each type of node
-	// ag = Name/Version/OS(or git commit hash for Prysm)
-
-	userAgent := new(UserAgent)
-	parts := strings.Split(ag, "/")
-
-nameChecker:
-	for name, identityNames := range clients {
-		for i := range identityNames {
-			if strings.EqualFold(identityNames[i], parts[0]) {
-				userAgent.Name = name
-				break nameChecker
-			}
-		}
+// setRecordEntries sets the optional entries of the node record
+func (p *Peer) setRecordEntries(node *enode.Node) {
+	p.ENRKeys = util.RecordKeys(node.Record())
+	var ip4 enr.IPv4
+	if node.Load(&ip4) == nil {
+		p.IP = net.IP(ip4).String()
 	}
-
-	if userAgent.Name == "" {
-		userAgent.Name = OthersClient
+	if syncnets, err := util.ParseEnrSyncnets(node); err == nil {
+		p.Syncnets = *syncnets
 	}
-
-	var os = ""
-	switch userAgent.Name {
-	case TekuClient:
-		if len(parts) > 2 {
-			userAgent.Version = parts[2]
-		}
-		if len(parts) > 3 {
-			os = parts[3]
-		}
-	case PrysmClient:
-		if len(parts) > 1 {
-			userAgent.Version = parts[1]
-		}
-	default:
-		if len(parts) > 1 {
-			userAgent.Version = parts[1]
-		}
-		if len(parts) > 2 {
-			os = parts[2]
+	var cgc util.CustodyGroupCountENREntry
+	if node.Load(&cgc) == nil {
+		p.CustodyGroupCount = uint64(cgc)
+	}
+	var nfd util.NextForkDigestENREntry
+	if node.Load(&nfd) == nil {
+		if digest, err := nfd.ForkDigest(); err == nil {
+			p.NextForkDigest = digest
 		}
 	}
-	// update the version and os to standard form
-	versions := strings.Split(userAgent.Version, "-")
-	versions = strings.Split(versions[0], "+")
-	userAgent.Version = versions[0]
-	if userAgent.Version == "" {
-		userAgent.Version = VersionUnknown
+	var quic util.QUICENREntry
+	if node.Load(&quic) == nil {
+		p.QUICPort = int(quic)
+	}
+	var ip6 enr.IPv6
+	if node.Load(&ip6) == nil {
+		p.IP6 = net.IP(ip6).String()
+	}
+	var tcp6 enr.TCP6
+	if node.Load(&tcp6) == nil {
+		p.TCP6Port = int(tcp6)
+	}
+	var udp6 enr.UDP6
+	if node.Load(&udp6) == nil {
+		p.UDP6Port = int(udp6)
 	}
+	var quic6 util.QUIC6ENREntry
+	if node.Load(&quic6) == nil {
+		p.QUIC6Port = int(quic6)
+	}
+}
 
-	var validOS = []OS{OSLinux, OSMAC, OSWindows}
-	for _, vos := range validOS {
-		if strings.Contains(strings.ToLower(os), strings.ToLower(string(vos))) {
-			userAgent.OS = vos
+// UpdateRecord sets the fields parsed from the node record of a newer peer,
+// it returns the changed fields
+func (p *Peer) UpdateRecord(newer *Peer) []*Change {
+	var changes []*Change
+	update := func(field, before, after string) {
+		if before != after {
+			changes = append(changes, &Change{Field: field, Old: before, New: after})
 		}
 	}
-	if userAgent.OS == "" {
-		userAgent.OS = OSUnknown
+	update("seq", strconv.FormatUint(p.Seq, 10), strconv.FormatUint(newer.Seq, 10))
+	update("network", p.Network, newer.Network)
+	update("ip", p.IP, newer.IP)
+	update("tcp_port", strconv.Itoa(p.TCPPort), strconv.Itoa(newer.TCPPort))
+	update("udp_port", strconv.Itoa(p.UDPPort), strconv.Itoa(newer.UDPPort))
+	update("quic_port", strconv.Itoa(p.QUICPort), strconv.Itoa(newer.QUICPort))
+	update("ip6", p.IP6, newer.IP6)
+	update("tcp6_port", strconv.Itoa(p.TCP6Port), strconv.Itoa(newer.TCP6Port))
+	update("udp6_port", strconv.Itoa(p.UDP6Port), strconv.Itoa(newer.UDP6Port))
+	update("quic6_port", strconv.Itoa(p.QUIC6Port), strconv.Itoa(newer.QUIC6Port))
+	update("addrs", strings.Join(p.Addrs, ","), strings.Join(newer.Addrs, ","))
+	update("enr_keys", strings.Join(p.ENRKeys, ","), strings.Join(newer.ENRKeys, ","))
+	update("attnets", hex.EncodeToString(p.Attnets[:]), hex.EncodeToString(newer.Attnets[:]))
+	update("syncnets", p.Syncnets.String(), newer.Syncnets.String())
+	update("custody_group_count", strconv.FormatUint(p.CustodyGroupCount, 10), strconv.FormatUint(newer.CustodyGroupCount, 10))
+	update("fork_digest", p.ForkDigestStr, newer.ForkDigestStr)
+	update("next_fork_version", p.NextForkVersion.String(), newer.NextForkVersion.String())
+	update("next_fork_epoch", p.NextForkEpoch.String(), newer.NextForkEpoch.String())
+	update("next_fork_digest", p.NextForkDigest.String(), newer.NextForkDigest.String())
+
+	// the locations are resolved again on the next successful probe
+	if p.IP != newer.IP || (newer.IP == "" && p.IP6 != newer.IP6) {
+		p.GeoLocation = nil
+	}
+	if p.IP6 != newer.IP6 {
+		p.GeoLocation6 = nil
 	}
-	p.UserAgent = userAgent
+	p.Seq = newer.Seq
+	p.ENR = newer.ENR
+	p.Network = newer.Network
+	p.IP = newer.IP
+	p.TCPPort = newer.TCPPort
+	p.UDPPort = newer.UDPPort
+	p.QUICPort = newer.QUICPort
+	p.IP6 = newer.IP6
+	p.TCP6Port = newer.TCP6Port
+	p.UDP6Port = newer.UDP6Port
+	p.QUIC6Port = newer.QUIC6Port
+	p.Addrs = newer.Addrs
+	p.ENRKeys = newer.ENRKeys
+	p.Attnets = newer.Attnets
+	p.Syncnets = newer.Syncnets
+	p.CustodyGroupCount = newer.CustodyGroupCount
+	p.ForkDigest = newer.ForkDigest
+	p.ForkDigestStr = newer.ForkDigestStr
+	p.ForkName = newer.ForkName
+	p.NextForkVersion = newer.NextForkVersion
+	p.NextForkEpoch = newer.NextForkEpoch
+	p.NextForkDigest = newer.NextForkDigest
+	return changes
+}
+
+// SetProtocolVersion sets peer's protocol version
+func (p *Peer) SetProtocolVersion(pv string) {
+	p.ProtocolVersion = pv
+}
+
+// SetIdentify sets the details the peer sent with the identify protocol
+func (p *Peer) SetIdentify(id *Identify, parser *UserAgentParser) {
+	p.SetProtocolVersion(id.ProtocolVersion)
+	p.SetUserAgent(id.AgentVersion, parser)
+	p.Protocols = id.Protocols
+	p.ListenAddrs = id.ListenAddrs
+	p.ObservedAddr = id.ObservedAddr
+}
+
+// SetUserAgent sets peer's agent info
+func (p *Peer) SetUserAgent(ag string, parser *UserAgentParser) {
+	p.UserAgent = parser.Parse(ag)
 	p.UserAgentRaw = ag
 }
 
@@ -276,27 +368,87 @@ func (p *Peer) SetConnectionStatus(status bool) {
 	}
 }
 
-// SetSyncStatus sets the sync status of a peer
-func (p *Peer) SetSyncStatus(block int64) {
-	cb := util.CurrentBlock()
-	if cb-block <= blockIgnoreThreshold {
-		p.Sync = &Sync{
-			Status:   true,
-			Distance: 0,
-		}
-	} else {
-		p.Sync = &Sync{
-			Status:   false,
-			Distance: int(((cb - block) * 100) / cb),
-		}
+// SetTransports sets the transports the crawler is connected to the peer with
+func (p *Peer) SetTransports(transports []string) {
+	p.Transports = transports
+}
+
+// SetNegotiation sets the security protocol and stream muxer negotiated with the peer
+func (p *Peer) SetNegotiation(security, muxer string) {
+	p.Security = security
+	p.Muxer = muxer
+}
+
+// SetSyncStatus sets the sync state of a peer from its head slot
+func (p *Peer) SetSyncStatus(headSlot uint64, network *Network, thresholds *SyncThresholds) {
+	p.Sync = NewSync(headSlot, network.CurrentSlot(), thresholds)
+}
+
+// SetChainStatus sets the chain view of the peer from its status response received at epoch
+func (p *Peer) SetChainStatus(status *common.Status, epoch common.Epoch) {
+	p.Chain = NewChainStatus(status, epoch)
+}
+
+// CurrentForkDigest returns the fork digest of the last status of the peer,
+// or the record one before the peer answered a status
+func (p *Peer) CurrentForkDigest() common.ForkDigest {
+	if p.Chain != nil && p.Chain.ForkDigest != (common.ForkDigest{}) {
+		return p.Chain.ForkDigest
+	}
+	return p.ForkDigest
+}
+
+// SetMetaData sets the metadata served by the peer
+func (p *Peer) SetMetaData(md *MetaData) {
+	md.UpdatedAt = time.Now().Unix()
+	p.MetaData = md
+}
+
+// SetUDPLiveness sets the result of the discv5 ping
+func (p *Peer) SetUDPLiveness(reachable bool, rtt time.Duration) {
+	p.UDP = &UDPLiveness{
+		Reachable: reachable,
+		RTT:       rtt.Milliseconds(),
+		CheckedAt: time.Now().Unix(),
 	}
 }
 
+// AddLatency adds a round trip time to the latency summary of the peer
+func (p *Peer) AddLatency(rtt time.Duration) {
+	if p.Latency == nil {
+		p.Latency = new(Latency)
+	}
+	p.Latency.Add(rtt)
+}
+
+// SetGoodbye sets the last goodbye message sent by the peer
+func (p *Peer) SetGoodbye(goodbye *Goodbye) {
+	p.Goodbye = goodbye
+}
+
+// SetFailure sets the last failure of the peer and counts it by reason
+func (p *Peer) SetFailure(reason FailureReason, err error) {
+	p.LastFailure = &Failure{
+		Reason: reason,
+		Error:  err.Error(),
+		Time:   time.Now().Unix(),
+	}
+	if p.FailureCounts == nil {
+		p.FailureCounts = make(map[FailureReason]int)
+	}
+	p.FailureCounts[reason]++
+}
+
 // SetGeoLocation sets the geolocation information
 func (p *Peer) SetGeoLocation(geoLocation *GeoLocation) {
 	p.GeoLocation = geoLocation
 }
 
+// SetGeoLocation6 sets the geolocation information of the ipv6 address
+func (p *Peer) SetGeoLocation6(geoLocation *GeoLocation) {
+	p.GeoLocation6 = geoLocation
+}
+
 // GetPeerInfo returns peer's AddrInfo
 func (p *Peer) GetPeerInfo() *peer.AddrInfo {
 	maddrs := make([]ma.Multiaddr, 0)
@@ -310,6 +462,30 @@ func (p *Peer) GetPeerInfo() *peer.AddrInfo {
 	}
 }
 
+// GetEnode returns the discovery node of the peer built from its stored record fields.
+// The ipv6 endpoint is used for the peers without an ipv4 one
+func (p *Peer) GetEnode() (*enode.Node, error) {
+	pkByte, err := hex.DecodeString(p.Pubkey)
+	if err != nil {
+		return nil, err
+	}
+	pubkey, err := crypto.DecompressPubkey(pkByte)
+	if err != nil {
+		return nil, err
+	}
+	if p.IP == "" && p.IP6 != "" {
+		tcp, udp := p.TCP6Port, p.UDP6Port
+		if tcp == 0 {
+			tcp = p.TCPPort
+		}
+		if udp == 0 {
+			udp = p.UDPPort
+		}
+		return enode.NewV4(pubkey, net.ParseIP(p.IP6), tcp, udp), nil
+	}
+	return enode.NewV4(pubkey, net.ParseIP(p.IP), p.TCPPort, p.UDPPort), nil
+}
+
 // String returns peer object's json form in string
 func (p *Peer) String() string {
 	if p == nil {
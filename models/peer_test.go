@@ -0,0 +1,83 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package models
+
+import (
+	"net"
+	"testing"
+
+	"eth2-crawler/crawler/util"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/protolambda/zrnt/eth2/beacon/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPeerUpdateRecord(t *testing.T) {
+	stored := &Peer{Seq: 1, IP: "1.2.3.4", TCPPort: 9000, UDPPort: 9000, GeoLocation: &GeoLocation{Country: "Germany"}}
+	newer := &Peer{Seq: 2, IP: "5.6.7.8", TCPPort: 9000, UDPPort: 9001}
+
+	changes := stored.UpdateRecord(newer)
+	assert.Equal(t, []*Change{
+		{Field: "seq", Old: "1", New: "2"},
+		{Field: "ip", Old: "1.2.3.4", New: "5.6.7.8"},
+		{Field: "udp_port", Old: "9000", New: "9001"},
+	}, changes)
+	assert.Equal(t, uint64(2), stored.Seq)
+	assert.Equal(t, "5.6.7.8", stored.IP)
+	assert.Equal(t, 9001, stored.UDPPort)
+	assert.Nil(t, stored.GeoLocation)
+}
+
+func TestNewPeerRecordEntries(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	var r enr.Record
+	r.Set(enr.IPv4(net.ParseIP("1.2.3.4")))
+	r.Set(enr.TCP(9000))
+	r.Set(enr.UDP(9000))
+	r.Set(enr.IPv6(net.ParseIP("2001:db8::1")))
+	r.Set(enr.TCP6(9100))
+	r.Set(util.QUICENREntry(9001))
+	r.Set(util.SyncnetsENREntry{0x05})
+	r.Set(util.CustodyGroupCountENREntry(8))
+	r.Set(util.NextForkDigestENREntry{0xcb, 0x0d, 0x1a, 0xcc})
+	require.NoError(t, enode.SignV4(&r, key))
+	node, err := enode.New(enode.ValidSchemes, &r)
+	require.NoError(t, err)
+
+	peer, err := NewPeer(node, &common.Eth2Data{}, "mainnet")
+	require.NoError(t, err)
+	assert.Equal(t, "1.2.3.4", peer.IP)
+	assert.Equal(t, []string{"/ip4/1.2.3.4/tcp/9000", "/ip4/1.2.3.4/udp/9001/quic-v1", "/ip6/2001:db8::1/tcp/9100", "/ip6/2001:db8::1/udp/9001/quic-v1"}, peer.Addrs)
+	assert.Equal(t, 9001, peer.QUICPort)
+	assert.Equal(t, "2001:db8::1", peer.IP6)
+	assert.Equal(t, 9100, peer.TCP6Port)
+	assert.Equal(t, 0, peer.UDP6Port)
+	assert.Equal(t, util.SyncnetBits{0x05}, peer.Syncnets)
+	assert.Equal(t, uint64(8), peer.CustodyGroupCount)
+	assert.Equal(t, common.ForkDigest{0xcb, 0x0d, 0x1a, 0xcc}, peer.NextForkDigest)
+	assert.Equal(t, []string{"cgc", "id", "ip", "ip6", "nfd", "quic", "secp256k1", "syncnets", "tcp", "tcp6", "udp"}, peer.ENRKeys)
+}
+
+func TestNewPeerQUICOnly(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	var r enr.Record
+	r.Set(enr.IPv4(net.ParseIP("1.2.3.4")))
+	r.Set(enr.UDP(9000))
+	r.Set(util.QUICENREntry(9001))
+	require.NoError(t, enode.SignV4(&r, key))
+	node, err := enode.New(enode.ValidSchemes, &r)
+	require.NoError(t, err)
+
+	assert.True(t, util.HasLibp2pPort(node))
+	peer, err := NewPeer(node, &common.Eth2Data{}, "mainnet")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/ip4/1.2.3.4/udp/9001/quic-v1"}, peer.Addrs)
+	assert.Equal(t, 0, peer.TCPPort)
+}
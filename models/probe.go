@@ -0,0 +1,14 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package models
+
+// ProbeState defines the reachability state of a peer, it decides when the peer is probed again
+type ProbeState string
+
+const (
+	ProbeStateNew     ProbeState = "new"     // never reached
+	ProbeStateHealthy ProbeState = "healthy" // last probe succeeded
+	ProbeStateFlaky   ProbeState = "flaky"   // reached before, failing since
+	ProbeStateDead    ProbeState = "dead"    // failing for too many consecutive probes
+)
@@ -0,0 +1,203 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package models
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"eth2-crawler/utils/config"
+
+	"github.com/hashicorp/go-version"
+	"github.com/protolambda/zrnt/eth2/beacon/common"
+)
+
+// ForkRequirement holds the minimum client versions ready for a fork of a network
+type ForkRequirement struct {
+	Network     string
+	Fork        string
+	MinVersions map[ClientName]*version.Version
+}
+
+// ForkRequirements holds the requirements of all the tracked forks
+type ForkRequirements []*ForkRequirement
+
+// NewForkRequirements initializes the fork requirements from the fork readiness data
+func NewForkRequirements(cfgs []*config.ForkReadiness, networks Networks) (ForkRequirements, error) {
+	requirements := make(ForkRequirements, 0, len(cfgs))
+	for _, cfg := range cfgs {
+		network := networks.ByName(cfg.Network)
+		if network == nil {
+			return nil, fmt.Errorf("unknown network %s", cfg.Network)
+		}
+		if network.forkIndex(cfg.Fork) < 0 {
+			return nil, fmt.Errorf("unknown fork %s of network %s", cfg.Fork, cfg.Network)
+		}
+		minVersions := make(map[ClientName]*version.Version, len(cfg.Clients))
+		for client, ver := range cfg.Clients {
+			v, err := parseClientVersion(ver)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s version of fork %s: %w", client, cfg.Fork, err)
+			}
+			minVersions[ClientName(client)] = v
+		}
+		requirements = append(requirements, &ForkRequirement{
+			Network:     cfg.Network,
+			Fork:        cfg.Fork,
+			MinVersions: minVersions,
+		})
+	}
+	return requirements, nil
+}
+
+// Find returns the requirement of the fork of network or nil
+func (r ForkRequirements) Find(network, fork string) *ForkRequirement {
+	for _, requirement := range r {
+		if requirement.Network == network && requirement.Fork == fork {
+			return requirement
+		}
+	}
+	return nil
+}
+
+// VersionReady checks the client version is at least the minimum one,
+// clients without a minimum version are not ready
+func (r *ForkRequirement) VersionReady(client ClientName, ver string) bool {
+	if r == nil {
+		return false
+	}
+	minVersion, ok := r.MinVersions[client]
+	if !ok {
+		return false
+	}
+	clientVersion, err := parseClientVersion(ver)
+	if err != nil {
+		return false
+	}
+	return clientVersion.GreaterThanOrEqual(minVersion)
+}
+
+func parseClientVersion(ver string) (*version.Version, error) {
+	if ver != "" && !strings.HasPrefix(ver, "v") {
+		ver = "v" + ver
+	}
+	return version.NewVersion(ver)
+}
+
+// forkIndex returns the index of the named fork in the network digests or -1
+func (n *Network) forkIndex(name string) int {
+	for i, d := range n.Digests {
+		if d.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// HasFork checks if the named fork, or blob schedule change, is part of the network schedule
+func (n *Network) HasFork(name string) bool {
+	return n.forkIndex(name) >= 0
+}
+
+// ScheduleReady checks the node record of a peer announces the fork as its next one,
+// or has the digest of the fork or of a later one
+func (n *Network) ScheduleReady(peer *Peer, fork string) bool {
+	index := n.forkIndex(fork)
+	if index < 0 {
+		return false
+	}
+	for i := index; i < len(n.Digests); i++ {
+		d := n.Digests[i]
+		if d.Digest == peer.ForkDigest {
+			return true
+		}
+		if d.Version == peer.NextForkVersion && d.Epoch == common.Epoch(peer.NextForkEpoch) {
+			return true
+		}
+	}
+	return false
+}
+
+// ReadinessCount counts the peers ready for a fork
+type ReadinessCount struct {
+	Total           int
+	ReadyByVersion  int // client version at least the minimum one
+	ReadyBySchedule int // fork announced in the node record, or already active
+	Ready           int // ready by version or by schedule
+}
+
+func (c *ReadinessCount) add(byVersion, bySchedule bool) {
+	c.Total++
+	if byVersion {
+		c.ReadyByVersion++
+	}
+	if bySchedule {
+		c.ReadyBySchedule++
+	}
+	if byVersion || bySchedule {
+		c.Ready++
+	}
+}
+
+// ReadyPercentage returns the percentage of ready peers
+func (c *ReadinessCount) ReadyPercentage() float64 {
+	if c.Total == 0 {
+		return 0
+	}
+	return float64(c.Ready) / float64(c.Total) * 100
+}
+
+// ClientForkReadiness counts the peers of a client ready for a fork
+type ClientForkReadiness struct {
+	Client ClientName
+	ReadinessCount
+}
+
+// ForkReadiness counts the peers of a network ready for a fork, overall and per client
+type ForkReadiness struct {
+	Network string
+	Fork    string
+	Epoch   common.Epoch
+	ReadinessCount
+	Clients []*ClientForkReadiness // most common clients first
+}
+
+// NewForkReadiness checks the readiness of the network peers for the fork. The versions are
+// only checked against requirement, peers of forks without one are ready by schedule only
+func NewForkReadiness(network *Network, fork string, requirement *ForkRequirement, peers []*Peer) (*ForkReadiness, error) {
+	index := network.forkIndex(fork)
+	if index < 0 {
+		return nil, fmt.Errorf("unknown fork %s of network %s", fork, network.Name)
+	}
+	result := &ForkReadiness{
+		Network: network.Name,
+		Fork:    fork,
+		Epoch:   network.Digests[index].Epoch,
+	}
+	clients := make(map[ClientName]*ClientForkReadiness)
+	for _, peer := range peers {
+		client, ver := OthersClient, ""
+		if peer.UserAgent != nil {
+			client, ver = peer.UserAgent.Name, peer.UserAgent.Version
+		}
+		byVersion := requirement.VersionReady(client, ver)
+		bySchedule := network.ScheduleReady(peer, fork)
+		result.add(byVersion, bySchedule)
+		if _, ok := clients[client]; !ok {
+			clients[client] = &ClientForkReadiness{Client: client}
+		}
+		clients[client].add(byVersion, bySchedule)
+	}
+	for _, c := range clients {
+		result.Clients = append(result.Clients, c)
+	}
+	sort.Slice(result.Clients, func(i, j int) bool {
+		if result.Clients[i].Total != result.Clients[j].Total {
+			return result.Clients[i].Total > result.Clients[j].Total
+		}
+		return result.Clients[i].Client < result.Clients[j].Client
+	})
+	return result, nil
+}
@@ -0,0 +1,58 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package models
+
+import (
+	"testing"
+
+	"eth2-crawler/utils/config"
+
+	"github.com/protolambda/zrnt/eth2/beacon/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewForkReadiness(t *testing.T) {
+	network, err := NewNetwork(mainnetConfig())
+	require.NoError(t, err)
+	requirements, err := NewForkRequirements([]*config.ForkReadiness{
+		{Network: "mainnet", Fork: "electra", Clients: map[string]string{"lighthouse": "v7.0.0", "teku": "25.4.1"}},
+	}, Networks{network})
+	require.NoError(t, err)
+	requirement := requirements.Find("mainnet", "electra")
+	require.NotNil(t, requirement)
+
+	digest := func(name string) common.ForkDigest {
+		return network.Digests[network.forkIndex(name)].Digest
+	}
+	peers := []*Peer{
+		// ready by version, still on deneb without the fork scheduled
+		{UserAgent: &UserAgent{Name: LighthouseClient, Version: "v7.0.1"}, ForkDigest: digest("deneb"),
+			NextForkVersion: common.Version{0x04}, NextForkEpoch: Epoch(^uint64(0))},
+		// outdated version announcing electra
+		{UserAgent: &UserAgent{Name: LighthouseClient, Version: "v6.0.0"}, ForkDigest: digest("deneb"),
+			NextForkVersion: common.Version{0x05}, NextForkEpoch: 364032},
+		// not ready at all
+		{UserAgent: &UserAgent{Name: TekuClient, Version: "25.1.0"}, ForkDigest: digest("deneb"),
+			NextForkVersion: common.Version{0x04}, NextForkEpoch: Epoch(^uint64(0))},
+		// already past the fork, without a known user agent
+		{ForkDigest: digest("fulu")},
+	}
+	readiness, err := NewForkReadiness(network, "electra", requirement, peers)
+	require.NoError(t, err)
+	assert.Equal(t, common.Epoch(364032), readiness.Epoch)
+	assert.Equal(t, ReadinessCount{Total: 4, ReadyByVersion: 1, ReadyBySchedule: 2, Ready: 3}, readiness.ReadinessCount)
+	assert.Equal(t, 75.0, readiness.ReadyPercentage())
+
+	require.Len(t, readiness.Clients, 3)
+	assert.Equal(t, LighthouseClient, readiness.Clients[0].Client)
+	assert.Equal(t, ReadinessCount{Total: 2, ReadyByVersion: 1, ReadyBySchedule: 1, Ready: 2}, readiness.Clients[0].ReadinessCount)
+
+	_, err = NewForkReadiness(network, "gloas", requirement, peers)
+	assert.Error(t, err)
+	_, err = NewForkRequirements([]*config.ForkReadiness{
+		{Network: "mainnet", Fork: "electra", Clients: map[string]string{"teku": "latest"}},
+	}, Networks{network})
+	assert.Error(t, err)
+}
@@ -0,0 +1,158 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package models
+
+import (
+	"sort"
+
+	"github.com/protolambda/zrnt/eth2/beacon/common"
+)
+
+// farFutureEpoch is the next fork epoch of the node records without a scheduled fork
+const farFutureEpoch = common.Epoch(^uint64(0))
+
+// ScheduleStatus tells how the fork schedule of a node record matches the one of its network
+type ScheduleStatus string
+
+const (
+	ScheduleCorrect     ScheduleStatus = "correct"
+	ScheduleMissingFork ScheduleStatus = "missing_fork" // the upcoming fork is not announced
+	ScheduleWrongFork   ScheduleStatus = "wrong_fork"   // on another fork, or announcing another version or epoch
+	ScheduleUnknownFork ScheduleStatus = "unknown_fork" // digest not part of the network schedule
+)
+
+// CurrentEpoch returns the current epoch of the network
+func (n *Network) CurrentEpoch() common.Epoch {
+	slot := n.CurrentSlot()
+	if slot < 0 {
+		return 0
+	}
+	return common.Epoch(uint64(slot) / n.SlotsPerEpoch)
+}
+
+// upcoming returns the digest index of the fork active at epoch and the next scheduled one,
+// next is -1 without one
+func (n *Network) upcoming(epoch common.Epoch) (current, next int) {
+	current, next = -1, -1
+	for i, d := range n.Digests {
+		if d.Epoch <= epoch {
+			current = i
+		} else if next < 0 {
+			next = i
+		}
+	}
+	return current, next
+}
+
+// CheckSchedule classifies the fork schedule of the peer node record at epoch. The blob
+// schedule changes keep the fork version, they are announced by the next fork digest only
+func (n *Network) CheckSchedule(peer *Peer, epoch common.Epoch) ScheduleStatus {
+	index := -1
+	for i, d := range n.Digests {
+		if d.Digest == peer.ForkDigest {
+			index = i
+		}
+	}
+	if index < 0 {
+		return ScheduleUnknownFork
+	}
+	current, next := n.upcoming(epoch)
+	if index != current {
+		return ScheduleWrongFork
+	}
+	if next < 0 {
+		if common.Epoch(peer.NextForkEpoch) == farFutureEpoch {
+			return ScheduleCorrect
+		}
+		return ScheduleWrongFork
+	}
+	d := n.Digests[next]
+	if peer.NextForkDigest == d.Digest || (peer.NextForkVersion == d.Version && common.Epoch(peer.NextForkEpoch) == d.Epoch) {
+		return ScheduleCorrect
+	}
+	if common.Epoch(peer.NextForkEpoch) == farFutureEpoch && peer.NextForkDigest == (common.ForkDigest{}) {
+		return ScheduleMissingFork
+	}
+	return ScheduleWrongFork
+}
+
+// UpcomingFork returns the name of the fork, or blob schedule change, following epoch,
+// or an empty string without one
+func (n *Network) UpcomingFork(epoch common.Epoch) string {
+	if _, next := n.upcoming(epoch); next >= 0 {
+		return n.Digests[next].Name
+	}
+	return ""
+}
+
+// ScheduleCheck is the fork schedule status of a peer
+type ScheduleCheck struct {
+	Peer         *Peer
+	Network      string
+	Status       ScheduleStatus
+	UpcomingFork string // empty when no fork is scheduled
+}
+
+// CheckSchedules classifies the fork schedule of the network peers at epoch
+func (n *Network) CheckSchedules(peers []*Peer, epoch common.Epoch) []*ScheduleCheck {
+	upcoming := n.UpcomingFork(epoch)
+	checks := make([]*ScheduleCheck, 0, len(peers))
+	for _, peer := range peers {
+		checks = append(checks, &ScheduleCheck{
+			Peer:         peer,
+			Network:      n.Name,
+			Status:       n.CheckSchedule(peer, epoch),
+			UpcomingFork: upcoming,
+		})
+	}
+	return checks
+}
+
+// MisconfigurationAggregation counts the misconfigured peers of a client version
+type MisconfigurationAggregation struct {
+	Client  ClientName
+	Version string
+	Status  ScheduleStatus
+	Count   int
+}
+
+// AggregateMisconfigured counts the misconfigured peers by client, version and status, the largest groups first
+func AggregateMisconfigured(checks []*ScheduleCheck) []*MisconfigurationAggregation {
+	type key struct {
+		client  ClientName
+		version string
+		status  ScheduleStatus
+	}
+	groups := make(map[key]*MisconfigurationAggregation)
+	for _, check := range checks {
+		if check.Status == ScheduleCorrect {
+			continue
+		}
+		k := key{client: OthersClient, version: VersionUnknown, status: check.Status}
+		if ua := check.Peer.UserAgent; ua != nil {
+			k.client, k.version = ua.Name, ua.Version
+		}
+		if _, ok := groups[k]; !ok {
+			groups[k] = &MisconfigurationAggregation{Client: k.client, Version: k.version, Status: k.status}
+		}
+		groups[k].Count++
+	}
+	result := make([]*MisconfigurationAggregation, 0, len(groups))
+	for _, group := range groups {
+		result = append(result, group)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Count != result[j].Count {
+			return result[i].Count > result[j].Count
+		}
+		if result[i].Client != result[j].Client {
+			return result[i].Client < result[j].Client
+		}
+		if result[i].Version != result[j].Version {
+			return result[i].Version < result[j].Version
+		}
+		return result[i].Status < result[j].Status
+	})
+	return result
+}
@@ -0,0 +1,70 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package models
+
+import (
+	"testing"
+
+	"github.com/protolambda/zrnt/eth2/beacon/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckSchedule(t *testing.T) {
+	network, err := NewNetwork(mainnetConfig())
+	require.NoError(t, err)
+	digest := func(name string) common.ForkDigest {
+		return network.Digests[network.forkIndex(name)].Digest
+	}
+	farFuture := Epoch(farFutureEpoch)
+
+	// before electra, the upcoming fork is announced by its version and epoch
+	deneb := common.Epoch(300000)
+	assert.Equal(t, "electra", network.UpcomingFork(deneb))
+	tests := []struct {
+		peer   *Peer
+		status ScheduleStatus
+	}{
+		{&Peer{ForkDigest: digest("deneb"), NextForkVersion: common.Version{0x05}, NextForkEpoch: 364032}, ScheduleCorrect},
+		{&Peer{ForkDigest: digest("deneb"), NextForkVersion: common.Version{0x04}, NextForkEpoch: farFuture}, ScheduleMissingFork},
+		{&Peer{ForkDigest: digest("deneb"), NextForkVersion: common.Version{0x05}, NextForkEpoch: 364000}, ScheduleWrongFork},
+		{&Peer{ForkDigest: digest("capella"), NextForkVersion: common.Version{0x04}, NextForkEpoch: 269568}, ScheduleWrongFork},
+		{&Peer{ForkDigest: common.ForkDigest{0x01, 0x02, 0x03, 0x04}}, ScheduleUnknownFork},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.status, network.CheckSchedule(tt.peer, deneb))
+	}
+
+	// after fulu, the blob schedule changes are announced by the next fork digest
+	fulu := common.Epoch(412000)
+	assert.Equal(t, "bpo1", network.UpcomingFork(fulu))
+	assert.Equal(t, ScheduleCorrect, network.CheckSchedule(&Peer{ForkDigest: digest("fulu"),
+		NextForkVersion: common.Version{0x06}, NextForkEpoch: farFuture, NextForkDigest: digest("bpo1")}, fulu))
+	assert.Equal(t, ScheduleMissingFork, network.CheckSchedule(&Peer{ForkDigest: digest("fulu"),
+		NextForkVersion: common.Version{0x06}, NextForkEpoch: farFuture}, fulu))
+	assert.Equal(t, ScheduleWrongFork, network.CheckSchedule(&Peer{ForkDigest: digest("fulu"),
+		NextForkVersion: common.Version{0x06}, NextForkEpoch: farFuture, NextForkDigest: digest("bpo2")}, fulu))
+
+	// without a scheduled fork, nothing must be announced
+	last := common.Epoch(420000)
+	assert.Equal(t, "", network.UpcomingFork(last))
+	assert.Equal(t, ScheduleCorrect, network.CheckSchedule(&Peer{ForkDigest: digest("bpo2"),
+		NextForkVersion: common.Version{0x06}, NextForkEpoch: farFuture}, last))
+	assert.Equal(t, ScheduleWrongFork, network.CheckSchedule(&Peer{ForkDigest: digest("bpo2"),
+		NextForkVersion: common.Version{0x07}, NextForkEpoch: 430000}, last))
+}
+
+func TestAggregateMisconfigured(t *testing.T) {
+	lighthouse := &UserAgent{Name: LighthouseClient, Version: "v7.0.0"}
+	checks := []*ScheduleCheck{
+		{Peer: &Peer{UserAgent: lighthouse}, Status: ScheduleMissingFork},
+		{Peer: &Peer{UserAgent: lighthouse}, Status: ScheduleMissingFork},
+		{Peer: &Peer{UserAgent: lighthouse}, Status: ScheduleCorrect},
+		{Peer: &Peer{}, Status: ScheduleUnknownFork},
+	}
+	assert.Equal(t, []*MisconfigurationAggregation{
+		{Client: LighthouseClient, Version: "v7.0.0", Status: ScheduleMissingFork, Count: 2},
+		{Client: OthersClient, Version: VersionUnknown, Status: ScheduleUnknownFork, Count: 1},
+	}, AggregateMisconfigured(checks))
+}
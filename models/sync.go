@@ -0,0 +1,103 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package models
+
+import "strconv"
+
+// SyncState defines how far the head of a peer is behind the current slot
+type SyncState string
+
+const (
+	SyncStateSynced    SyncState = "synced"
+	SyncStateSyncing   SyncState = "syncing"
+	SyncStateStale     SyncState = "stale"
+	SyncStateFarBehind SyncState = "far_behind"
+)
+
+// SyncThresholds are the max slot lags of the sync states, peers lagging more are far behind
+type SyncThresholds struct {
+	Synced  uint64
+	Syncing uint64
+	Stale   uint64
+}
+
+// State returns the sync state of a slot lag
+func (t *SyncThresholds) State(lag uint64) SyncState {
+	switch {
+	case lag <= t.Synced:
+		return SyncStateSynced
+	case lag <= t.Syncing:
+		return SyncStateSyncing
+	case lag <= t.Stale:
+		return SyncStateStale
+	default:
+		return SyncStateFarBehind
+	}
+}
+
+// Sync holds peer sync related info
+type Sync struct {
+	Status   bool      `json:"status" bson:"status"` // true when synced
+	State    SyncState `json:"state" bson:"state"`
+	HeadSlot uint64    `json:"head_slot" bson:"head_slot"`
+	Lag      uint64    `json:"lag" bson:"lag"` // slots between the head and the current slot
+}
+
+// NewSync returns the sync info of a head slot at the current slot.
+// Heads ahead of the current slot, from clock drifts, have no lag
+func NewSync(headSlot uint64, currentSlot int64, thresholds *SyncThresholds) *Sync {
+	var lag uint64
+	if currentSlot > 0 && uint64(currentSlot) > headSlot {
+		lag = uint64(currentSlot) - headSlot
+	}
+	state := thresholds.State(lag)
+	return &Sync{
+		Status:   state == SyncStateSynced,
+		State:    state,
+		HeadSlot: headSlot,
+		Lag:      lag,
+	}
+}
+
+// String returns the sync status
+func (s *Sync) String() string {
+	if s.Status {
+		return StatusSynced
+	}
+	return StatusUnsynced
+}
+
+// SyncLagBounds are the upper bounds, in slots, of the sync lag histogram buckets.
+// The last bucket holds the lags above them
+var SyncLagBounds = []uint64{0, 1, 2, 4, 8, 32, 64, 256, 1024, 8192, 65536}
+
+// SyncLagBucket counts the peers lagging up to a bound, and more than the previous one
+type SyncLagBucket struct {
+	UpperBound string // "+Inf" for the last bucket
+	Count      int
+}
+
+// ClientSyncLag is the sync lag histogram of the peers of a client
+type ClientSyncLag struct {
+	Client  string
+	Count   int
+	Buckets []*SyncLagBucket
+}
+
+// NewClientSyncLag builds the sync lag histogram of the client peers
+func NewClientSyncLag(client string, lags []uint64) *ClientSyncLag {
+	buckets := make([]*SyncLagBucket, 0, len(SyncLagBounds)+1)
+	for _, bound := range SyncLagBounds {
+		buckets = append(buckets, &SyncLagBucket{UpperBound: strconv.FormatUint(bound, 10)})
+	}
+	buckets = append(buckets, &SyncLagBucket{UpperBound: "+Inf"})
+	for _, lag := range lags {
+		i := 0
+		for i < len(SyncLagBounds) && lag > SyncLagBounds[i] {
+			i++
+		}
+		buckets[i].Count++
+	}
+	return &ClientSyncLag{Client: client, Count: len(lags), Buckets: buckets}
+}
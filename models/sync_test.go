@@ -0,0 +1,51 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSync(t *testing.T) {
+	thresholds := &SyncThresholds{Synced: 32, Syncing: 8192, Stale: 50400}
+	tests := []struct {
+		headSlot    uint64
+		currentSlot int64
+		lag         uint64
+		state       SyncState
+	}{
+		{headSlot: 1000, currentSlot: 1000, lag: 0, state: SyncStateSynced},
+		{headSlot: 1002, currentSlot: 1000, lag: 0, state: SyncStateSynced},
+		{headSlot: 968, currentSlot: 1000, lag: 32, state: SyncStateSynced},
+		{headSlot: 967, currentSlot: 1000, lag: 33, state: SyncStateSyncing},
+		{headSlot: 100000, currentSlot: 150400, lag: 50400, state: SyncStateStale},
+		{headSlot: 0, currentSlot: 150400, lag: 150400, state: SyncStateFarBehind},
+	}
+	for _, tt := range tests {
+		sync := NewSync(tt.headSlot, tt.currentSlot, thresholds)
+		assert.Equal(t, tt.headSlot, sync.HeadSlot)
+		assert.Equal(t, tt.lag, sync.Lag)
+		assert.Equal(t, tt.state, sync.State)
+		assert.Equal(t, tt.state == SyncStateSynced, sync.Status)
+	}
+}
+
+func TestNewClientSyncLag(t *testing.T) {
+	histogram := NewClientSyncLag("lighthouse", []uint64{0, 0, 1, 3, 40, 100000})
+	assert.Equal(t, 6, histogram.Count)
+	assert.Len(t, histogram.Buckets, len(SyncLagBounds)+1)
+
+	counts := make(map[string]int)
+	for _, bucket := range histogram.Buckets {
+		counts[bucket.UpperBound] = bucket.Count
+	}
+	assert.Equal(t, 2, counts["0"])
+	assert.Equal(t, 1, counts["1"])
+	assert.Equal(t, 1, counts["4"])
+	assert.Equal(t, 1, counts["64"])
+	assert.Equal(t, 1, counts["+Inf"])
+	assert.Equal(t, 0, counts["2"])
+}
@@ -0,0 +1,104 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package models
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"eth2-crawler/utils/config"
+)
+
+// userAgentRule matches the user agents of a client
+type userAgentRule struct {
+	client  ClientName
+	pattern *regexp.Regexp
+}
+
+// UserAgentParser parses the user agents with ordered rules, the first matching rule sets
+// the client. Agents matching no rule belong to other clients
+type UserAgentParser struct {
+	rules []*userAgentRule
+}
+
+// NewUserAgentParser compiles the user agent rules
+func NewUserAgentParser(cfgs []*config.UserAgentRule) (*UserAgentParser, error) {
+	rules := make([]*userAgentRule, 0, len(cfgs))
+	for _, cfg := range cfgs {
+		pattern, err := regexp.Compile(cfg.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid user agent rule of %s: %w", cfg.Client, err)
+		}
+		rules = append(rules, &userAgentRule{client: ClientName(cfg.Client), pattern: pattern})
+	}
+	return &UserAgentParser{rules: rules}, nil
+}
+
+// Parse returns the client info of a user agent
+func (p *UserAgentParser) Parse(ag string) *UserAgent {
+	userAgent := &UserAgent{Name: OthersClient}
+	for _, rule := range p.rules {
+		match := rule.pattern.FindStringSubmatch(ag)
+		if match == nil {
+			continue
+		}
+		userAgent.Name = rule.client
+		var os string
+		for i, group := range rule.pattern.SubexpNames() {
+			switch group {
+			case "version":
+				userAgent.Version = match[i]
+			case "build":
+				userAgent.Build = match[i]
+			case "commit":
+				userAgent.Commit = strings.ToLower(match[i])
+			case "os":
+				os = match[i]
+			case "arch":
+				userAgent.Arch = normalizeArch(match[i])
+			}
+		}
+		userAgent.OS = normalizeOS(os)
+		break
+	}
+	if userAgent.Version == "" {
+		userAgent.Version = VersionUnknown
+	}
+	if userAgent.OS == "" {
+		userAgent.OS = OSUnknown
+	}
+	return userAgent
+}
+
+// normalizeOS returns the os of the agent os name
+func normalizeOS(os string) OS {
+	os = strings.ToLower(os)
+	switch {
+	case strings.Contains(os, "linux"):
+		return OSLinux
+	case strings.Contains(os, "mac"), strings.Contains(os, "darwin"), strings.Contains(os, "osx"):
+		return OSMAC
+	case strings.Contains(os, "windows"):
+		return OSWindows
+	default:
+		return OSUnknown
+	}
+}
+
+// archAliases maps the architecture names of the clients to a single one
+var archAliases = map[string]string{
+	"amd64":    "x86_64",
+	"x64":      "x86_64",
+	"arm64":    "aarch64",
+	"aarch_64": "aarch64",
+}
+
+func normalizeArch(arch string) string {
+	arch = strings.ToLower(arch)
+	if alias, ok := archAliases[arch]; ok {
+		return alias
+	}
+	return arch
+}
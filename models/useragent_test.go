@@ -0,0 +1,66 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package models
+
+import (
+	"os"
+	"testing"
+
+	"eth2-crawler/utils/config"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
+)
+
+type userAgentCase struct {
+	Agent   string `yaml:"agent"`
+	Client  string `yaml:"client"`
+	Version string `yaml:"version"`
+	Build   string `yaml:"build"`
+	Commit  string `yaml:"commit"`
+	OS      string `yaml:"os"`
+	Arch    string `yaml:"arch"`
+}
+
+func loadYAML(t *testing.T, path string, out interface{}) {
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.NoError(t, yaml.Unmarshal(data, out))
+}
+
+// TestUserAgentCorpus parses the golden corpus with the rules of the default config
+func TestUserAgentCorpus(t *testing.T) {
+	var rules []*config.UserAgentRule
+	loadYAML(t, "../cmd/config/user_agents.yaml", &rules)
+	parser, err := NewUserAgentParser(rules)
+	require.NoError(t, err)
+
+	var cases []*userAgentCase
+	loadYAML(t, "testdata/user_agents.yaml", &cases)
+	require.NotEmpty(t, cases)
+	for _, c := range cases {
+		assert.Equal(t, &UserAgent{
+			Name:    ClientName(c.Client),
+			Version: c.Version,
+			Build:   c.Build,
+			Commit:  c.Commit,
+			OS:      OS(c.OS),
+			Arch:    c.Arch,
+		}, parser.Parse(c.Agent), c.Agent)
+	}
+}
+
+func TestUserAgentParserOrder(t *testing.T) {
+	parser, err := NewUserAgentParser([]*config.UserAgentRule{
+		{Client: "first", Pattern: `^client/(?P<version>\d+)`},
+		{Client: "second", Pattern: `^client`},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, ClientName("first"), parser.Parse("client/1").Name)
+	assert.Equal(t, ClientName("second"), parser.Parse("client/beta").Name)
+
+	_, err = NewUserAgentParser([]*config.UserAgentRule{{Client: "broken", Pattern: `(`}})
+	assert.Error(t, err)
+}
@@ -0,0 +1,62 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+// Package mongo implements all the store methods
+package mongo
+
+import (
+	"context"
+	"eth2-crawler/models"
+	"eth2-crawler/store/event"
+	"eth2-crawler/utils/config"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
+)
+
+type mongoStore struct {
+	client  *mongo.Client
+	coll    *mongo.Collection
+	timeout time.Duration
+}
+
+// New creates new instance of Event Store based on MongoDB
+func New(cfg *config.Database) (event.Provider, error) {
+	timeout := time.Duration(cfg.Timeout) * time.Second
+	opts := options.Client()
+
+	opts.ApplyURI(cfg.URI)
+	client, err := mongo.NewClient(opts)
+	if err != nil {
+		return nil, fmt.Errorf("connecton error [%s]: %w", opts.GetURI(), err)
+	}
+
+	// connect to the mongoDB cluster
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	err = client.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// test the connection
+	err = client.Ping(ctx, readpref.Primary())
+	if err != nil {
+		return nil, err
+	}
+
+	return &mongoStore{
+		client:  client,
+		coll:    client.Database(cfg.Database).Collection(cfg.EventCollection),
+		timeout: timeout,
+	}, nil
+}
+
+func (s mongoStore) Create(ctx context.Context, event *models.Event) error {
+	_, err := s.coll.InsertOne(ctx, event, options.InsertOne())
+	return err
+}
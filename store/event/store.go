@@ -0,0 +1,16 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+// Package event implements db for peer events
+package event
+
+import (
+	"context"
+
+	"eth2-crawler/models"
+)
+
+// Provider represents store provider interface that can be implemented by different DB engines
+type Provider interface {
+	Create(ctx context.Context, event *models.Event) error
+}
@@ -6,5 +6,6 @@ package peerstore
 import "errors"
 
 var (
-	ErrPeerNotFound = errors.New("unable to find the node")
+	ErrPeerNotFound  = errors.New("unable to find the node")
+	ErrRecordChanged = errors.New("the node record changed")
 )
@@ -16,7 +16,7 @@ import (
 	"eth2-crawler/store/peerstore"
 	"eth2-crawler/utils/config"
 
-	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p/core/peer"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
@@ -41,17 +41,68 @@ func (s *mongoStore) Create(ctx context.Context, peer *models.Peer) error {
 	return nil
 }
 
+// recordFields are the peer fields set from its node record, the locations are reset with the addresses
+var recordFields = []string{
+	"seq", "enr", "network", "ip", "tcp_port", "udp_port", "quic_port", "ip6", "tcp6_port", "udp6_port",
+	"quic6_port", "addrs", "enr_keys", "attnets", "syncnets", "custody_group_count", "fork_digest",
+	"fork_digest_str", "fork_name", "next_fork_version", "next_fork_epoch", "next_fork_digest",
+	"geo_location", "geo_location6",
+}
+
 func (s *mongoStore) Update(ctx context.Context, peer *models.Peer) error {
 	filter := bson.D{
 		{Key: "_id", Value: peer.ID},
+		seqFilter(bson.D{{Key: "$eq", Value: peer.Seq}}, peer.Seq == 0),
 	}
-	_, err := s.coll.ReplaceOne(ctx, filter, peer)
+	res, err := s.coll.ReplaceOne(ctx, filter, peer)
 	if err != nil {
 		return err
 	}
+	if res.MatchedCount == 0 {
+		return peerstore.ErrRecordChanged
+	}
 	return nil
 }
 
+func (s *mongoStore) UpdateRecord(ctx context.Context, peer *models.Peer) error {
+	data, err := bson.Marshal(peer)
+	if err != nil {
+		return err
+	}
+	var doc bson.M
+	if err = bson.Unmarshal(data, &doc); err != nil {
+		return err
+	}
+	set := make(bson.D, 0, len(recordFields))
+	for _, field := range recordFields {
+		set = append(set, bson.E{Key: field, Value: doc[field]})
+	}
+	filter := bson.D{
+		{Key: "_id", Value: peer.ID},
+		seqFilter(bson.D{{Key: "$lt", Value: peer.Seq}}, true),
+	}
+	res, err := s.coll.UpdateOne(ctx, filter, bson.D{{Key: "$set", Value: set}})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return peerstore.ErrRecordChanged
+	}
+	return nil
+}
+
+// seqFilter matches the sequence number of the node record with cond. The peers stored
+// before the sequence numbers were kept don't have one, they are matched too when missing is set
+func seqFilter(cond bson.D, missing bool) bson.E {
+	if !missing {
+		return bson.E{Key: "seq", Value: cond}
+	}
+	return bson.E{Key: "$or", Value: bson.A{
+		bson.D{{Key: "seq", Value: cond}},
+		bson.D{{Key: "seq", Value: bson.D{{Key: "$exists", Value: false}}}},
+	}}
+}
+
 func (s *mongoStore) Delete(ctx context.Context, peer *models.Peer) error {
 	filter := bson.D{
 		{Key: "_id", Value: peer.ID},
@@ -104,6 +155,16 @@ func AddPeerFilterToQueryPipeline(query mongo.Pipeline, peerFilter *model.PeerFi
 			return nil, err
 		}
 	}
+	if peerFilter != nil && peerFilter.Network != nil {
+		query = append(query, bson.D{
+			{Key: "$match", Value: bson.D{{Key: "network", Value: *peerFilter.Network}}},
+		})
+	}
+	if peerFilter != nil && peerFilter.ForkName != nil {
+		query = append(query, bson.D{
+			{Key: "$match", Value: bson.D{{Key: "fork_name", Value: *peerFilter.ForkName}}},
+		})
+	}
 
 	return query, nil
 }
@@ -142,13 +203,21 @@ func (s *mongoStore) ViewAll(ctx context.Context, peerFilter *model.PeerFilter)
 	return peers, nil
 }
 
-func (s *mongoStore) ListForJob(ctx context.Context, lastUpdated time.Duration, limit int) ([]*models.Peer, error) {
+func (s *mongoStore) ListForJob(ctx context.Context, exclude []peer.ID, limit int) ([]*models.Peer, error) {
 	var peers []*models.Peer
-	timeToSkip := time.Now().Add(-lastUpdated).Unix()
 	opts := options.Find()
 	opts.SetLimit(int64(limit))
-	opts.SetSort(bson.D{{Key: "last_updated", Value: 1}})
-	filter := bson.D{{Key: "last_updated", Value: bson.D{{Key: "$lt", Value: timeToSkip}}}}
+	opts.SetSort(bson.D{{Key: "next_probe_at", Value: 1}})
+	// peers stored before the scheduler have no next probe time and are due
+	filter := bson.D{
+		{Key: "$or", Value: bson.A{
+			bson.D{{Key: "next_probe_at", Value: bson.D{{Key: "$lte", Value: time.Now().Unix()}}}},
+			bson.D{{Key: "next_probe_at", Value: bson.D{{Key: "$exists", Value: false}}}},
+		}},
+	}
+	if len(exclude) > 0 {
+		filter = append(filter, bson.E{Key: "_id", Value: bson.D{{Key: "$nin", Value: exclude}}})
+	}
 	cursor, err := s.coll.Find(ctx, filter, opts)
 	if err != nil {
 		return nil, err
@@ -409,6 +478,485 @@ func (s *mongoStore) AggregateByNetworkType(ctx context.Context, peerFilter *mod
 	return result, nil
 }
 
+// AggregateByFailureReason counts the peers whose last probe failed by the reason of the failure.
+// The peers once reachable are counted too, their last failure is more recent than their last connection
+func (s *mongoStore) AggregateByFailureReason(ctx context.Context, peerFilter *model.PeerFilter) ([]*models.AggregateData, error) {
+	query := mongo.Pipeline{
+		bson.D{
+			{Key: "$match", Value: bson.D{
+				{Key: "$and", Value: bson.A{
+					bson.D{{Key: "last_failure", Value: bson.D{{Key: "$ne", Value: nil}}}},
+					bson.D{{Key: "$expr", Value: bson.D{
+						{Key: "$gte", Value: bson.A{"$last_failure.time", "$last_connected"}},
+					}}},
+				}},
+			}},
+		},
+	}
+
+	var err error
+	query, err = AddPeerFilterToQueryPipeline(query, peerFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	query = append(query, bson.D{
+		{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: "$last_failure.reason"},
+			{Key: "count", Value: bson.D{{Key: "$sum", Value: 1}}},
+		}},
+	})
+
+	cursor, err := s.coll.Aggregate(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*models.AggregateData
+	for cursor.Next(ctx) {
+		// create a value into which the single document can be decoded
+		data := new(aggregateData)
+		err := cursor.Decode(data)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, &models.AggregateData{Name: data.ID, Count: data.Count})
+	}
+	return result, nil
+}
+
+// AggregateByCapability counts the peers advertising each of the optional node record keys
+func (s *mongoStore) AggregateByCapability(ctx context.Context, peerFilter *model.PeerFilter) ([]*models.AggregateData, error) {
+	query := mongo.Pipeline{
+		bson.D{
+			{Key: "$match", Value: bson.D{
+				{Key: "is_connectable", Value: bson.D{{Key: "$eq", Value: true}}},
+			}},
+		},
+	}
+
+	var err error
+	query, err = AddPeerFilterToQueryPipeline(query, peerFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	query = append(query,
+		bson.D{{Key: "$unwind", Value: "$enr_keys"}},
+		bson.D{{Key: "$match", Value: bson.D{
+			{Key: "enr_keys", Value: bson.D{{Key: "$in", Value: models.Capabilities}}},
+		}}},
+		bson.D{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: "$enr_keys"},
+			{Key: "count", Value: bson.D{{Key: "$sum", Value: 1}}},
+		}}},
+	)
+
+	cursor, err := s.coll.Aggregate(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*models.AggregateData
+	for cursor.Next(ctx) {
+		// create a value into which the single document can be decoded
+		data := new(aggregateData)
+		err := cursor.Decode(data)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, &models.AggregateData{Name: data.ID, Count: data.Count})
+	}
+	return result, nil
+}
+
+// AggregateByProtocol counts the peers serving each of the protocols they sent with identify
+func (s *mongoStore) AggregateByProtocol(ctx context.Context, peerFilter *model.PeerFilter) ([]*models.AggregateData, error) {
+	query := mongo.Pipeline{
+		bson.D{
+			{Key: "$match", Value: bson.D{
+				{Key: "is_connectable", Value: bson.D{{Key: "$eq", Value: true}}},
+			}},
+		},
+	}
+
+	var err error
+	query, err = AddPeerFilterToQueryPipeline(query, peerFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	query = append(query,
+		bson.D{{Key: "$unwind", Value: "$protocols"}},
+		bson.D{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: "$protocols"},
+			{Key: "count", Value: bson.D{{Key: "$sum", Value: 1}}},
+		}}},
+	)
+
+	cursor, err := s.coll.Aggregate(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*models.AggregateData
+	for cursor.Next(ctx) {
+		// create a value into which the single document can be decoded
+		data := new(aggregateData)
+		err := cursor.Decode(data)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, &models.AggregateData{Name: data.ID, Count: data.Count})
+	}
+	return result, nil
+}
+
+// latencyGroupFields are the peer fields the latencies are grouped by
+var latencyGroupFields = map[model.LatencyGroup]string{
+	model.LatencyGroupCountry: "$geo_location.country",
+	model.LatencyGroupAsnType: "$geo_location.asn.type",
+	model.LatencyGroupClient:  "$user_agent.name",
+}
+
+type latencyGroup struct {
+	ID        string  `bson:"_id"`
+	Latencies []int64 `bson:"latencies"`
+}
+
+// LatencyPercentiles computes the percentiles of the median latencies of the peers in each group
+func (s *mongoStore) LatencyPercentiles(ctx context.Context, groupBy model.LatencyGroup, peerFilter *model.PeerFilter) ([]*models.LatencyPercentiles, error) {
+	field, ok := latencyGroupFields[groupBy]
+	if !ok {
+		return nil, fmt.Errorf("unknown latency group %s", groupBy)
+	}
+	query := mongo.Pipeline{
+		bson.D{
+			{Key: "$match", Value: bson.D{
+				{Key: "$and", Value: bson.A{
+					bson.D{{Key: "is_connectable", Value: bson.D{{Key: "$eq", Value: true}}}},
+					bson.D{{Key: "latency_us", Value: bson.D{{Key: "$ne", Value: nil}}}},
+				}},
+			}},
+		},
+	}
+
+	var err error
+	query, err = AddPeerFilterToQueryPipeline(query, peerFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	// the percentiles are computed here, the $percentile operator needs a recent mongodb
+	query = append(query, bson.D{
+		{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: field},
+			{Key: "latencies", Value: bson.D{{Key: "$push", Value: "$latency_us.median"}}},
+		}},
+	})
+
+	cursor, err := s.coll.Aggregate(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*models.LatencyPercentiles
+	for cursor.Next(ctx) {
+		data := new(latencyGroup)
+		err := cursor.Decode(data)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, models.NewLatencyPercentiles(data.ID, data.Latencies))
+	}
+	return result, nil
+}
+
+type chainAggregation struct {
+	ID struct {
+		Root  string `bson:"root"`
+		Point uint64 `bson:"point"`
+	} `bson:"_id"`
+	Count  int `bson:"count"`
+	Behind int `bson:"behind"`
+}
+
+// AggregateByFinalizedCheckpoint counts the peers on each finalized checkpoint, the most common first.
+// The peers finalizing late are compared with the epoch of their status, the statuses are received at different times
+func (s *mongoStore) AggregateByFinalizedCheckpoint(ctx context.Context, since int64, peerFilter *model.PeerFilter) ([]*models.CheckpointAggregation, error) {
+	behind := bson.E{Key: "behind", Value: bson.D{{Key: "$sum", Value: bson.D{{Key: "$cond", Value: bson.A{
+		bson.D{{Key: "$gt", Value: bson.A{
+			bson.D{{Key: "$subtract", Value: bson.A{"$chain.epoch", "$chain.finalized_epoch"}}},
+			models.MaxFinalityDelay,
+		}}},
+		1, 0,
+	}}}}}}
+	groups, err := s.aggregateByChainPoint(ctx, since, "$chain.finalized_root", "$chain.finalized_epoch", peerFilter, behind)
+	if err != nil {
+		return nil, err
+	}
+	var result []*models.CheckpointAggregation
+	for _, group := range groups {
+		result = append(result, &models.CheckpointAggregation{
+			Epoch:  group.ID.Point,
+			Root:   group.ID.Root,
+			Count:  group.Count,
+			Behind: group.Behind,
+		})
+	}
+	return result, nil
+}
+
+// AggregateByHeadRoot counts the peers on each head block, the most common first
+func (s *mongoStore) AggregateByHeadRoot(ctx context.Context, since int64, peerFilter *model.PeerFilter) ([]*models.HeadAggregation, error) {
+	groups, err := s.aggregateByChainPoint(ctx, since, "$chain.head_root", "$chain.head_slot", peerFilter)
+	if err != nil {
+		return nil, err
+	}
+	var result []*models.HeadAggregation
+	for _, group := range groups {
+		result = append(result, &models.HeadAggregation{Slot: group.ID.Point, Root: group.ID.Root, Count: group.Count})
+	}
+	return result, nil
+}
+
+// aggregateByChainPoint counts the connectable peers by a block root and its slot or epoch, from
+// the statuses received since the given time so the views compared are close in time
+func (s *mongoStore) aggregateByChainPoint(ctx context.Context, since int64, root, point string, peerFilter *model.PeerFilter,
+	accumulators ...bson.E) ([]*chainAggregation, error) {
+	query := mongo.Pipeline{
+		bson.D{
+			{Key: "$match", Value: bson.D{
+				{Key: "$and", Value: bson.A{
+					bson.D{{Key: "is_connectable", Value: bson.D{{Key: "$eq", Value: true}}}},
+					bson.D{{Key: "chain.updated_at", Value: bson.D{{Key: "$gte", Value: since}}}},
+				}},
+			}},
+		},
+	}
+
+	var err error
+	query, err = AddPeerFilterToQueryPipeline(query, peerFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	group := bson.D{
+		{Key: "_id", Value: bson.D{
+			{Key: "root", Value: root},
+			{Key: "point", Value: point},
+		}},
+		{Key: "count", Value: bson.D{{Key: "$sum", Value: 1}}},
+	}
+	group = append(group, accumulators...)
+	query = append(query,
+		bson.D{{Key: "$group", Value: group}},
+		bson.D{{Key: "$sort", Value: bson.D{{Key: "count", Value: -1}}}},
+	)
+
+	cursor, err := s.coll.Aggregate(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*chainAggregation
+	for cursor.Next(ctx) {
+		data := new(chainAggregation)
+		err := cursor.Decode(data)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, data)
+	}
+	return result, nil
+}
+
+// AggregateByIPStack counts the peers by the ip versions of their endpoints
+func (s *mongoStore) AggregateByIPStack(ctx context.Context, peerFilter *model.PeerFilter) ([]*models.AggregateData, error) {
+	query := mongo.Pipeline{
+		bson.D{
+			{Key: "$match", Value: bson.D{
+				{Key: "is_connectable", Value: bson.D{{Key: "$eq", Value: true}}},
+			}},
+		},
+	}
+
+	var err error
+	query, err = AddPeerFilterToQueryPipeline(query, peerFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	hasIP := bson.D{{Key: "$gt", Value: bson.A{"$ip", ""}}}
+	hasIP6 := bson.D{{Key: "$gt", Value: bson.A{"$ip6", ""}}}
+	query = append(query, bson.D{
+		{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: bson.D{{Key: "$switch", Value: bson.D{
+				{Key: "branches", Value: bson.A{
+					bson.D{{Key: "case", Value: bson.D{{Key: "$and", Value: bson.A{hasIP, hasIP6}}}}, {Key: "then", Value: models.IPStackDual}},
+					bson.D{{Key: "case", Value: hasIP6}, {Key: "then", Value: models.IPStackIPv6}},
+				}},
+				{Key: "default", Value: models.IPStackIPv4},
+			}}}},
+			{Key: "count", Value: bson.D{{Key: "$sum", Value: 1}}},
+		}},
+	})
+
+	cursor, err := s.coll.Aggregate(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*models.AggregateData
+	for cursor.Next(ctx) {
+		// create a value into which the single document can be decoded
+		data := new(aggregateData)
+		err := cursor.Decode(data)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, &models.AggregateData{Name: data.ID, Count: data.Count})
+	}
+	return result, nil
+}
+
+// AggregateBySecurity counts the connectable peers by the security protocol negotiated with them
+func (s *mongoStore) AggregateBySecurity(ctx context.Context, peerFilter *model.PeerFilter) ([]*models.AggregateData, error) {
+	return s.aggregateByField(ctx, "security", peerFilter)
+}
+
+// AggregateByMuxer counts the connectable peers by the stream muxer negotiated with them
+func (s *mongoStore) AggregateByMuxer(ctx context.Context, peerFilter *model.PeerFilter) ([]*models.AggregateData, error) {
+	return s.aggregateByField(ctx, "muxer", peerFilter)
+}
+
+// aggregateByField counts the connectable peers by a field, peers probed before it was recorded are skipped
+func (s *mongoStore) aggregateByField(ctx context.Context, field string, peerFilter *model.PeerFilter) ([]*models.AggregateData, error) {
+	query := mongo.Pipeline{
+		bson.D{
+			{Key: "$match", Value: bson.D{
+				{Key: "$and", Value: bson.A{
+					bson.D{{Key: "is_connectable", Value: bson.D{{Key: "$eq", Value: true}}}},
+					bson.D{{Key: field, Value: bson.D{{Key: "$nin", Value: bson.A{nil, ""}}}}},
+				}},
+			}},
+		},
+	}
+
+	var err error
+	query, err = AddPeerFilterToQueryPipeline(query, peerFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	query = append(query, bson.D{
+		{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: "$" + field},
+			{Key: "count", Value: bson.D{{Key: "$sum", Value: 1}}},
+		}},
+	})
+
+	cursor, err := s.coll.Aggregate(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*models.AggregateData
+	for cursor.Next(ctx) {
+		data := new(aggregateData)
+		err := cursor.Decode(data)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, &models.AggregateData{Name: data.ID, Count: data.Count})
+	}
+	return result, nil
+}
+
+// ReparseUserAgents parses every distinct stored raw user agent again and updates the peers sending it
+func (s *mongoStore) ReparseUserAgents(ctx context.Context, parser *models.UserAgentParser) (int, error) {
+	raws, err := s.coll.Distinct(ctx, "user_agent_raw", bson.D{{Key: "user_agent_raw", Value: bson.D{{Key: "$ne", Value: ""}}}})
+	if err != nil {
+		return 0, err
+	}
+	updated := 0
+	for _, value := range raws {
+		raw, ok := value.(string)
+		if !ok {
+			continue
+		}
+		res, err := s.coll.UpdateMany(ctx,
+			bson.D{{Key: "user_agent_raw", Value: raw}},
+			bson.D{{Key: "$set", Value: bson.D{{Key: "user_agent", Value: parser.Parse(raw)}}}})
+		if err != nil {
+			return updated, err
+		}
+		updated += int(res.ModifiedCount)
+	}
+	return updated, nil
+}
+
+// AggregateBySyncState counts the connectable peers in each sync state
+func (s *mongoStore) AggregateBySyncState(ctx context.Context, peerFilter *model.PeerFilter) ([]*models.AggregateData, error) {
+	return s.aggregateByField(ctx, "sync.state", peerFilter)
+}
+
+type syncLagGroup struct {
+	ID   string   `bson:"_id"`
+	Lags []uint64 `bson:"lags"`
+}
+
+// SyncLagByClient builds the sync lag histogram of the peers of each client
+func (s *mongoStore) SyncLagByClient(ctx context.Context, peerFilter *model.PeerFilter) ([]*models.ClientSyncLag, error) {
+	query := mongo.Pipeline{
+		bson.D{
+			{Key: "$match", Value: bson.D{
+				{Key: "$and", Value: bson.A{
+					bson.D{{Key: "is_connectable", Value: bson.D{{Key: "$eq", Value: true}}}},
+					bson.D{{Key: "sync.lag", Value: bson.D{{Key: "$exists", Value: true}}}},
+				}},
+			}},
+		},
+	}
+
+	var err error
+	query, err = AddPeerFilterToQueryPipeline(query, peerFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	query = append(query,
+		bson.D{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: "$user_agent.name"},
+			{Key: "lags", Value: bson.D{{Key: "$push", Value: "$sync.lag"}}},
+		}}},
+		bson.D{{Key: "$sort", Value: bson.D{{Key: "_id", Value: 1}}}},
+	)
+
+	cursor, err := s.coll.Aggregate(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*models.ClientSyncLag
+	for cursor.Next(ctx) {
+		data := new(syncLagGroup)
+		err := cursor.Decode(data)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, models.NewClientSyncLag(data.ID, data.Lags))
+	}
+	return result, nil
+}
+
 type count struct {
 	Count int `json:"count" bson:"count"`
 }
@@ -493,9 +1041,18 @@ func New(cfg *config.Database) (peerstore.Provider, error) {
 		return nil, err
 	}
 
+	coll := client.Database(cfg.Database).Collection(cfg.Collection)
+	// the probe jobs are picked by next probe time
+	_, err = coll.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "next_probe_at", Value: 1}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error creating next probe index: %w", err)
+	}
+
 	return &mongoStore{
 		client:  client,
-		coll:    client.Database(cfg.Database).Collection(cfg.Collection),
+		coll:    coll,
 		timeout: timeout,
 	}, nil
 }
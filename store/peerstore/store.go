@@ -6,27 +6,46 @@ package peerstore
 
 import (
 	"context"
-	"time"
 
 	"eth2-crawler/graph/model"
 	"eth2-crawler/models"
 
-	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p/core/peer"
 )
 
 // Provider represents store provider interface that can be implemented by different DB engines
 type Provider interface {
 	Create(ctx context.Context, peer *models.Peer) error
+	// Update replaces the stored peer, ErrRecordChanged is returned when its node record
+	// was updated since it was read
 	Update(ctx context.Context, peer *models.Peer) error
+	// UpdateRecord sets the node record fields of the stored peer, ErrRecordChanged is returned
+	// when the stored record is not older
+	UpdateRecord(ctx context.Context, peer *models.Peer) error
 	View(ctx context.Context, peerID peer.ID) (*models.Peer, error)
 	Delete(ctx context.Context, peer *models.Peer) error
 	// Todo: accept filter and find options to get limited information
 	ViewAll(ctx context.Context, peerFilter *model.PeerFilter) ([]*models.Peer, error)
-	ListForJob(ctx context.Context, lastUpdated time.Duration, limit int) ([]*models.Peer, error)
+	// ListForJob returns the peers due to a probe, except the excluded ones
+	ListForJob(ctx context.Context, exclude []peer.ID, limit int) ([]*models.Peer, error)
 	AggregateByAgentName(ctx context.Context, peerFilter *model.PeerFilter) ([]*models.AggregateData, error)
 	AggregateByOperatingSystem(ctx context.Context, peerFilter *model.PeerFilter) ([]*models.AggregateData, error)
 	AggregateByCountry(ctx context.Context, peerFilter *model.PeerFilter) ([]*models.AggregateData, error)
 	AggregateByNetworkType(ctx context.Context, peerFilter *model.PeerFilter) ([]*models.AggregateData, error)
 	AggregateBySyncStatus(ctx context.Context, peerFilter *model.PeerFilter) (*models.SyncAggregateData, error)
+	AggregateBySyncState(ctx context.Context, peerFilter *model.PeerFilter) ([]*models.AggregateData, error)
+	SyncLagByClient(ctx context.Context, peerFilter *model.PeerFilter) ([]*models.ClientSyncLag, error)
 	AggregateByClientVersion(ctx context.Context, peerFilter *model.PeerFilter) ([]*models.ClientVersionAggregation, error)
+	AggregateByFailureReason(ctx context.Context, peerFilter *model.PeerFilter) ([]*models.AggregateData, error)
+	AggregateByCapability(ctx context.Context, peerFilter *model.PeerFilter) ([]*models.AggregateData, error)
+	AggregateByProtocol(ctx context.Context, peerFilter *model.PeerFilter) ([]*models.AggregateData, error)
+	LatencyPercentiles(ctx context.Context, groupBy model.LatencyGroup, peerFilter *model.PeerFilter) ([]*models.LatencyPercentiles, error)
+	// AggregateByFinalizedCheckpoint and AggregateByHeadRoot count the statuses received since the given time only
+	AggregateByFinalizedCheckpoint(ctx context.Context, since int64, peerFilter *model.PeerFilter) ([]*models.CheckpointAggregation, error)
+	AggregateByHeadRoot(ctx context.Context, since int64, peerFilter *model.PeerFilter) ([]*models.HeadAggregation, error)
+	AggregateByIPStack(ctx context.Context, peerFilter *model.PeerFilter) ([]*models.AggregateData, error)
+	AggregateBySecurity(ctx context.Context, peerFilter *model.PeerFilter) ([]*models.AggregateData, error)
+	AggregateByMuxer(ctx context.Context, peerFilter *model.PeerFilter) ([]*models.AggregateData, error)
+	// ReparseUserAgents parses the stored raw user agents again, it returns the number of updated peers
+	ReparseUserAgents(ctx context.Context, parser *models.UserAgentParser) (int, error)
 }
@@ -7,20 +7,36 @@ package config
 import (
 	"errors"
 	"fmt"
+	"net"
 	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
 
 	"gopkg.in/yaml.v2"
 )
 
 // Configuration holds data necessary for configuring application
 type Configuration struct {
-	Server   *Server   `yaml:"server,omitempty"`
-	Database *Database `yaml:"database,omitempty"`
-	Resolver *Resolver `yaml:"resolver,omitempty"`
+	Server   *Server    `yaml:"server,omitempty"`
+	Database *Database  `yaml:"database,omitempty"`
+	Resolver *Resolver  `yaml:"resolver,omitempty"`
+	Networks []*Network `yaml:"networks,omitempty"`
+	Crawler  *Crawler   `yaml:"crawler,omitempty"`
+
+	// ForkReadinessPath is the data file of the minimum client versions, relative to the config file
+	ForkReadinessPath string           `yaml:"fork_readiness_path,omitempty"`
+	ForkReadiness     []*ForkReadiness `yaml:"-"`
+
+	// UserAgentRulesPath is the required data file of the user agent rules, relative to the config file
+	UserAgentRulesPath string           `yaml:"user_agent_rules_path"`
+	UserAgentRules     []*UserAgentRule `yaml:"-"`
 }
 
 // Server holds data necessary for server configuration
 type Server struct {
+	AdminToken        string   `yaml:"-"` // admin mutations are disabled without it
 	Port              string   `yaml:"port,omitempty"`
 	ReadTimeout       int      `yaml:"read_timeout_seconds,omitempty"`
 	ReadHeaderTimeout int      `yaml:"read_header_timeout_seconds,omitempty"`
@@ -35,6 +51,7 @@ type Database struct {
 	Database          string `yaml:"database"`
 	Collection        string `yaml:"collection"`
 	HistoryCollection string `yaml:"history_collection"`
+	EventCollection   string `yaml:"event_collection"`
 }
 
 // Resolver provides config for resolver
@@ -43,6 +60,353 @@ type Resolver struct {
 	Timeout int    `yaml:"request_timeout_sec"`
 }
 
+// Crawler holds data necessary for crawler configuration.
+// Empty paths keep the crawler identity and node database in memory only
+type Crawler struct {
+	ListenAddress     string   `yaml:"listen_address"`
+	ListenAddress6    string   `yaml:"listen_address6"` // ipv6 is disabled when empty
+	ListenPort        int      `yaml:"listen_port"`
+	Concurrency       int      `yaml:"concurrency"`
+	MaxConnections    int      `yaml:"max_connections"`
+	RefreshInterval   int      `yaml:"refresh_interval_seconds"`
+	FullProbeInterval int      `yaml:"full_probe_interval_seconds"` // identify interval of the known peers
+	PollInterval      int      `yaml:"poll_interval_seconds"`
+	BackoffBase       int      `yaml:"backoff_base_seconds"`
+	BackoffMax        int      `yaml:"backoff_max_seconds"`
+	DeadAfter         int      `yaml:"dead_after_failures"`
+	Security          []string `yaml:"security"`        // security transports in order of preference
+	Muxers            []string `yaml:"muxers"`          // stream muxers in order of preference
+	SyncedMaxLag      *uint64  `yaml:"synced_max_lag"`  // slots behind the current one a peer is still synced, 0 is a valid lag
+	SyncingMaxLag     *uint64  `yaml:"syncing_max_lag"` // slots behind a syncing peer may be
+	StaleMaxLag       *uint64  `yaml:"stale_max_lag"`   // slots behind a stale peer may be, peers lagging more are far behind
+	KeyPath           string   `yaml:"key_path"`
+	NodeDBPath        string   `yaml:"node_db_path"`
+}
+
+// setDefaults fills the crawler settings missing from the config file
+func (c *Crawler) setDefaults() {
+	if c.ListenAddress == "" {
+		c.ListenAddress = "0.0.0.0"
+	}
+	if c.ListenPort == 0 {
+		c.ListenPort = 30304
+	}
+	if c.Concurrency == 0 {
+		c.Concurrency = 200
+	}
+	if c.MaxConnections == 0 {
+		c.MaxConnections = 400
+	}
+	if c.RefreshInterval == 0 {
+		c.RefreshInterval = 24 * 60 * 60
+	}
+	if c.FullProbeInterval == 0 {
+		c.FullProbeInterval = 7 * 24 * 60 * 60
+	}
+	if c.PollInterval == 0 {
+		c.PollInterval = 5
+	}
+	if c.BackoffBase == 0 {
+		c.BackoffBase = 60
+	}
+	if c.BackoffMax == 0 {
+		c.BackoffMax = 6 * 60 * 60
+	}
+	if c.DeadAfter == 0 {
+		c.DeadAfter = 5
+	}
+	if len(c.Security) == 0 {
+		c.Security = []string{"noise"}
+	}
+	if len(c.Muxers) == 0 {
+		c.Muxers = []string{"yamux", "mplex"}
+	}
+	// the lags are pointers, a zero lag is set on purpose
+	setDefaultUint(&c.SyncedMaxLag, 32)
+	setDefaultUint(&c.SyncingMaxLag, 8192)
+	setDefaultUint(&c.StaleMaxLag, 50400)
+}
+
+func setDefaultUint(dest **uint64, value uint64) {
+	if *dest == nil {
+		*dest = &value
+	}
+}
+
+// loadEnvs overrides the crawler settings with the CRAWLER_* environment variables
+func (c *Crawler) loadEnvs() error {
+	loadEnvString("CRAWLER_LISTEN_ADDRESS", &c.ListenAddress)
+	loadEnvString("CRAWLER_LISTEN_ADDRESS6", &c.ListenAddress6)
+	loadEnvString("CRAWLER_KEY_PATH", &c.KeyPath)
+	loadEnvString("CRAWLER_NODE_DB_PATH", &c.NodeDBPath)
+	loadEnvList("CRAWLER_SECURITY", &c.Security)
+	loadEnvList("CRAWLER_MUXERS", &c.Muxers)
+	ints := map[string]*int{
+		"CRAWLER_LISTEN_PORT":                 &c.ListenPort,
+		"CRAWLER_CONCURRENCY":                 &c.Concurrency,
+		"CRAWLER_MAX_CONNECTIONS":             &c.MaxConnections,
+		"CRAWLER_REFRESH_INTERVAL_SECONDS":    &c.RefreshInterval,
+		"CRAWLER_FULL_PROBE_INTERVAL_SECONDS": &c.FullProbeInterval,
+		"CRAWLER_POLL_INTERVAL_SECONDS":       &c.PollInterval,
+		"CRAWLER_BACKOFF_BASE_SECONDS":        &c.BackoffBase,
+		"CRAWLER_BACKOFF_MAX_SECONDS":         &c.BackoffMax,
+		"CRAWLER_DEAD_AFTER_FAILURES":         &c.DeadAfter,
+	}
+	for key, dest := range ints {
+		if err := loadEnvInt(key, dest); err != nil {
+			return err
+		}
+	}
+	uints := map[string]*uint64{
+		"CRAWLER_SYNCED_MAX_LAG":  c.SyncedMaxLag,
+		"CRAWLER_SYNCING_MAX_LAG": c.SyncingMaxLag,
+		"CRAWLER_STALE_MAX_LAG":   c.StaleMaxLag,
+	}
+	for key, dest := range uints {
+		if err := loadEnvUint(key, dest); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Crawler) validate() error {
+	if ip := net.ParseIP(c.ListenAddress); ip == nil || ip.To4() == nil {
+		return fmt.Errorf("invalid listen_address %s", c.ListenAddress)
+	}
+	if c.ListenAddress6 != "" {
+		if ip := net.ParseIP(c.ListenAddress6); ip == nil || ip.To4() != nil {
+			return fmt.Errorf("invalid listen_address6 %s", c.ListenAddress6)
+		}
+	}
+	if c.ListenPort <= 0 || c.ListenPort > 65535 {
+		return fmt.Errorf("invalid listen_port %d", c.ListenPort)
+	}
+	if c.Concurrency <= 0 {
+		return errors.New("concurrency must be positive")
+	}
+	if c.MaxConnections < c.Concurrency {
+		return errors.New("max_connections must not be lower than concurrency")
+	}
+	if c.RefreshInterval <= 0 {
+		return errors.New("refresh_interval_seconds must be positive")
+	}
+	if c.FullProbeInterval < c.RefreshInterval {
+		return errors.New("full_probe_interval_seconds must not be lower than refresh_interval_seconds")
+	}
+	if c.PollInterval <= 0 {
+		return errors.New("poll_interval_seconds must be positive")
+	}
+	if c.BackoffBase <= 0 {
+		return errors.New("backoff_base_seconds must be positive")
+	}
+	if c.BackoffMax < c.BackoffBase {
+		return errors.New("backoff_max_seconds must not be lower than backoff_base_seconds")
+	}
+	if c.DeadAfter <= 0 {
+		return errors.New("dead_after_failures must be positive")
+	}
+	if *c.SyncingMaxLag <= *c.SyncedMaxLag {
+		return errors.New("syncing_max_lag must be greater than synced_max_lag")
+	}
+	if *c.StaleMaxLag <= *c.SyncingMaxLag {
+		return errors.New("stale_max_lag must be greater than syncing_max_lag")
+	}
+	if err := validateNames("security", c.Security, "noise", "tls"); err != nil {
+		return err
+	}
+	return validateNames("muxers", c.Muxers, "yamux", "mplex")
+}
+
+// validateNames checks the setting lists only known names, each of them once
+func validateNames(setting string, names []string, known ...string) error {
+	seen := make(map[string]bool)
+	for _, name := range names {
+		if !slices.Contains(known, name) {
+			return fmt.Errorf("unknown %s %s", setting, name)
+		}
+		if seen[name] {
+			return fmt.Errorf("duplicate %s %s", setting, name)
+		}
+		seen[name] = true
+	}
+	return nil
+}
+
+// Network holds the chain configuration of an eth2 network to crawl
+type Network struct {
+	Name                  string           `yaml:"name"`
+	Bootnodes             []string         `yaml:"bootnodes"`
+	GenesisTime           int64            `yaml:"genesis_time"`
+	GenesisValidatorsRoot string           `yaml:"genesis_validators_root"`
+	SecondsPerSlot        uint64           `yaml:"seconds_per_slot"`
+	SlotsPerEpoch         uint64           `yaml:"slots_per_epoch"`
+	Forks                 []*Fork          `yaml:"forks"`
+	BlobSchedule          []*BlobParameter `yaml:"blob_schedule"`
+}
+
+// Fork holds an entry of the network fork schedule
+type Fork struct {
+	Name    string `yaml:"name"`
+	Version string `yaml:"version"`
+	Epoch   uint64 `yaml:"epoch"`
+}
+
+// BlobParameter holds an entry of the network blob schedule
+type BlobParameter struct {
+	Epoch            uint64 `yaml:"epoch"`
+	MaxBlobsPerBlock uint64 `yaml:"max_blobs_per_block"`
+}
+
+func validateNetworks(networks []*Network) error {
+	if len(networks) == 0 {
+		return errors.New("at least one network is required")
+	}
+	names := make(map[string]bool)
+	for _, network := range networks {
+		if network.Name == "" {
+			return errors.New("network name is required")
+		}
+		if names[network.Name] {
+			return fmt.Errorf("duplicate network %s", network.Name)
+		}
+		names[network.Name] = true
+		if len(network.Bootnodes) == 0 {
+			return fmt.Errorf("network %s: at least one bootnode is required", network.Name)
+		}
+		if network.GenesisTime <= 0 {
+			return fmt.Errorf("network %s: genesis_time is required", network.Name)
+		}
+		if network.GenesisValidatorsRoot == "" {
+			return fmt.Errorf("network %s: genesis_validators_root is required", network.Name)
+		}
+		if network.SecondsPerSlot == 0 {
+			return fmt.Errorf("network %s: seconds_per_slot is required", network.Name)
+		}
+		if network.SlotsPerEpoch == 0 {
+			return fmt.Errorf("network %s: slots_per_epoch is required", network.Name)
+		}
+		if len(network.Forks) == 0 {
+			return fmt.Errorf("network %s: fork schedule is required", network.Name)
+		}
+	}
+	return nil
+}
+
+// ForkReadiness holds the minimum client versions ready for a fork of a network
+type ForkReadiness struct {
+	Network string            `yaml:"network"`
+	Fork    string            `yaml:"fork"`
+	Clients map[string]string `yaml:"clients"` // client name to minimum version
+}
+
+// loadForkReadiness loads the fork readiness data file, the forks must be part of the networks
+func loadForkReadiness(path string, networks []*Network) ([]*ForkReadiness, error) {
+	bytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading fork readiness file, %w", err)
+	}
+	var entries []*ForkReadiness
+	if err = yaml.Unmarshal(bytes, &entries); err != nil {
+		return nil, fmt.Errorf("unable to decode fork readiness file, %w", err)
+	}
+	seen := make(map[string]bool)
+	for _, entry := range entries {
+		key := entry.Network + "/" + entry.Fork
+		if seen[key] {
+			return nil, fmt.Errorf("duplicate fork readiness %s", key)
+		}
+		seen[key] = true
+		if len(entry.Clients) == 0 {
+			return nil, fmt.Errorf("fork readiness %s: at least one client is required", key)
+		}
+		if !slices.ContainsFunc(networks, func(n *Network) bool { return n.Name == entry.Network }) {
+			return nil, fmt.Errorf("fork readiness %s: unknown network", key)
+		}
+	}
+	return entries, nil
+}
+
+// UserAgentRule matches the user agents of a client. The rules are tried in order,
+// the named groups of the pattern capture the version, build, commit, os and arch
+type UserAgentRule struct {
+	Client  string `yaml:"client"`
+	Pattern string `yaml:"pattern"`
+}
+
+// loadUserAgentRules loads the user agent rules data file
+func loadUserAgentRules(path string) ([]*UserAgentRule, error) {
+	bytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading user agent rules file, %w", err)
+	}
+	var rules []*UserAgentRule
+	if err = yaml.Unmarshal(bytes, &rules); err != nil {
+		return nil, fmt.Errorf("unable to decode user agent rules file, %w", err)
+	}
+	for i, rule := range rules {
+		if rule.Client == "" || rule.Pattern == "" {
+			return nil, fmt.Errorf("user agent rule %d: client and pattern are required", i)
+		}
+	}
+	return rules, nil
+}
+
+// dataPath resolves the path of a data file relative to the config file
+func dataPath(configPath, path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(filepath.Dir(configPath), path)
+}
+
+func loadEnvString(key string, dest *string) {
+	if value := os.Getenv(key); value != "" {
+		*dest = value
+	}
+}
+
+// loadEnvList loads a comma separated list
+func loadEnvList(key string, dest *[]string) {
+	value := os.Getenv(key)
+	if value == "" {
+		return
+	}
+	list := make([]string, 0)
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	*dest = list
+}
+
+func loadEnvInt(key string, dest *int) error {
+	value := os.Getenv(key)
+	if value == "" {
+		return nil
+	}
+	i, err := strconv.Atoi(value)
+	if err != nil {
+		return fmt.Errorf("invalid %s: %w", key, err)
+	}
+	*dest = i
+	return nil
+}
+
+func loadEnvUint(key string, dest *uint64) error {
+	value := os.Getenv(key)
+	if value == "" {
+		return nil
+	}
+	i, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid %s: %w", key, err)
+	}
+	*dest = i
+	return nil
+}
+
 func loadDatabaseURI() (string, error) {
 	mongoURI := os.Getenv("MONGODB_URI")
 	if mongoURI == "" {
@@ -73,7 +437,35 @@ func Load(path string) (*Configuration, error) {
 		return nil, fmt.Errorf("unable to decode into struct, %w", err)
 	}
 
+	if err = validateNetworks(cfg.Networks); err != nil {
+		return nil, fmt.Errorf("invalid network config, %w", err)
+	}
+
+	if cfg.ForkReadinessPath != "" {
+		if cfg.ForkReadiness, err = loadForkReadiness(dataPath(path, cfg.ForkReadinessPath), cfg.Networks); err != nil {
+			return nil, err
+		}
+	}
+	// without rules every peer would be counted as an other client
+	if cfg.UserAgentRulesPath == "" {
+		return nil, errors.New("user_agent_rules_path is required")
+	}
+	if cfg.UserAgentRules, err = loadUserAgentRules(dataPath(path, cfg.UserAgentRulesPath)); err != nil {
+		return nil, err
+	}
+
+	if cfg.Crawler == nil {
+		cfg.Crawler = new(Crawler)
+	}
+	cfg.Crawler.setDefaults()
+
 	// load envs
+	if err = cfg.Crawler.loadEnvs(); err != nil {
+		return nil, err
+	}
+	if err = cfg.Crawler.validate(); err != nil {
+		return nil, fmt.Errorf("invalid crawler config, %w", err)
+	}
 	cfg.Database.URI, err = loadDatabaseURI()
 	if err != nil {
 		return nil, err
@@ -82,6 +474,9 @@ func Load(path string) (*Configuration, error) {
 	if err != nil {
 		return nil, err
 	}
+	if cfg.Server != nil {
+		cfg.Server.AdminToken = os.Getenv("ADMIN_TOKEN")
+	}
 
 	return cfg, nil
 }
@@ -0,0 +1,195 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testConfig = `
+database:
+  request_timeout_sec: 5
+resolver:
+  request_timeout_sec: 3
+networks:
+  - name: mainnet
+    genesis_time: 1606824023
+    genesis_validators_root: "0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95"
+    seconds_per_slot: 12
+    slots_per_epoch: 32
+    bootnodes: ["enr:-test"]
+    forks:
+      - name: phase0
+        version: "0x00000000"
+        epoch: 0
+user_agent_rules_path: user_agents.yaml
+`
+
+const testUserAgentRules = `
+- client: lighthouse
+  pattern: '^lighthouse/(?P<version>[^/]+)'
+`
+
+func writeConfig(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+	rules := filepath.Join(filepath.Dir(path), "user_agents.yaml")
+	require.NoError(t, os.WriteFile(rules, []byte(testUserAgentRules), 0600))
+	t.Setenv("MONGODB_URI", "mongodb://localhost:27017")
+	t.Setenv("RESOLVER_API_KEY", "key")
+	return path
+}
+
+func TestLoadCrawlerDefaults(t *testing.T) {
+	cfg, err := Load(writeConfig(t, testConfig))
+	require.NoError(t, err)
+	assert.Equal(t, "0.0.0.0", cfg.Crawler.ListenAddress)
+	assert.Equal(t, 30304, cfg.Crawler.ListenPort)
+	assert.Equal(t, 200, cfg.Crawler.Concurrency)
+	assert.Equal(t, 400, cfg.Crawler.MaxConnections)
+	assert.Equal(t, 86400, cfg.Crawler.RefreshInterval)
+	assert.Equal(t, 604800, cfg.Crawler.FullProbeInterval)
+	assert.Equal(t, 60, cfg.Crawler.BackoffBase)
+	assert.Equal(t, 21600, cfg.Crawler.BackoffMax)
+	assert.Equal(t, 5, cfg.Crawler.DeadAfter)
+	assert.Equal(t, []string{"noise"}, cfg.Crawler.Security)
+	assert.Equal(t, []string{"yamux", "mplex"}, cfg.Crawler.Muxers)
+	assert.Equal(t, uint64(32), *cfg.Crawler.SyncedMaxLag)
+	assert.Equal(t, uint64(8192), *cfg.Crawler.SyncingMaxLag)
+	assert.Equal(t, uint64(50400), *cfg.Crawler.StaleMaxLag)
+
+	cfg, err = Load(writeConfig(t, testConfig+`
+crawler:
+  synced_max_lag: 0
+`))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), *cfg.Crawler.SyncedMaxLag)
+}
+
+func TestLoadCrawlerEnvOverrides(t *testing.T) {
+	path := writeConfig(t, testConfig+`
+crawler:
+  listen_port: 9000
+  concurrency: 10
+`)
+	t.Setenv("CRAWLER_CONCURRENCY", "20")
+	t.Setenv("CRAWLER_KEY_PATH", "/data/node.key")
+	t.Setenv("CRAWLER_MUXERS", "mplex, yamux")
+	t.Setenv("CRAWLER_SYNCED_MAX_LAG", "64")
+	cfg, err := Load(path)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(64), *cfg.Crawler.SyncedMaxLag)
+	assert.Equal(t, []string{"mplex", "yamux"}, cfg.Crawler.Muxers)
+	assert.Equal(t, 9000, cfg.Crawler.ListenPort)
+	assert.Equal(t, 20, cfg.Crawler.Concurrency)
+	assert.Equal(t, "/data/node.key", cfg.Crawler.KeyPath)
+
+	t.Setenv("CRAWLER_CONCURRENCY", "many")
+	_, err = Load(path)
+	assert.Error(t, err)
+}
+
+func TestLoadCrawlerValidation(t *testing.T) {
+	_, err := Load(writeConfig(t, testConfig+`
+crawler:
+  listen_address: localhost
+`))
+	assert.Error(t, err)
+
+	_, err = Load(writeConfig(t, testConfig+`
+crawler:
+  listen_port: 70000
+`))
+	assert.Error(t, err)
+
+	_, err = Load(writeConfig(t, testConfig+`
+crawler:
+  listen_address6: 0.0.0.0
+`))
+	assert.Error(t, err)
+
+	_, err = Load(writeConfig(t, testConfig+`
+crawler:
+  backoff_base_seconds: 600
+  backoff_max_seconds: 60
+`))
+	assert.Error(t, err)
+
+	_, err = Load(writeConfig(t, testConfig+`
+crawler:
+  synced_max_lag: 10000
+`))
+	assert.Error(t, err)
+
+	_, err = Load(writeConfig(t, testConfig+`
+crawler:
+  security: [noise, secio]
+`))
+	assert.Error(t, err)
+
+	_, err = Load(writeConfig(t, testConfig+`
+crawler:
+  muxers: [yamux, yamux]
+`))
+	assert.Error(t, err)
+
+	_, err = Load(writeConfig(t, testConfig+`
+crawler:
+  concurrency: 100
+  max_connections: 50
+`))
+	assert.Error(t, err)
+}
+
+func TestLoadForkReadiness(t *testing.T) {
+	path := writeConfig(t, testConfig+`
+fork_readiness_path: forks.yaml
+`)
+	forks := filepath.Join(filepath.Dir(path), "forks.yaml")
+	require.NoError(t, os.WriteFile(forks, []byte(`
+- network: mainnet
+  fork: altair
+  clients:
+    prysm: v2.0.0
+`), 0600))
+	cfg, err := Load(path)
+	require.NoError(t, err)
+	require.Len(t, cfg.ForkReadiness, 1)
+	assert.Equal(t, "altair", cfg.ForkReadiness[0].Fork)
+	assert.Equal(t, map[string]string{"prysm": "v2.0.0"}, cfg.ForkReadiness[0].Clients)
+
+	require.NoError(t, os.WriteFile(forks, []byte(`
+- network: holesky
+  fork: altair
+  clients:
+    prysm: v2.0.0
+`), 0600))
+	_, err = Load(path)
+	assert.Error(t, err)
+}
+
+func TestLoadUserAgentRules(t *testing.T) {
+	path := writeConfig(t, testConfig)
+	t.Setenv("ADMIN_TOKEN", "secret")
+	cfg, err := Load(path)
+	require.NoError(t, err)
+	require.Len(t, cfg.UserAgentRules, 1)
+	assert.Equal(t, "lighthouse", cfg.UserAgentRules[0].Client)
+
+	rules := filepath.Join(filepath.Dir(path), "user_agents.yaml")
+	require.NoError(t, os.WriteFile(rules, []byte(`
+- client: lighthouse
+`), 0600))
+	_, err = Load(path)
+	assert.Error(t, err)
+
+	_, err = Load(writeConfig(t, strings.Replace(testConfig, "user_agent_rules_path: user_agents.yaml\n", "", 1)))
+	assert.Error(t, err)
+}
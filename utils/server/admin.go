@@ -0,0 +1,31 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	"net/http"
+	"strings"
+)
+
+type adminKey struct{}
+
+// AdminAuth marks the requests bearing the admin token as admin ones,
+// no request is an admin one without a token
+func AdminAuth(token string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		bearer, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if ok && token != "" && subtle.ConstantTimeCompare([]byte(bearer), []byte(token)) == 1 {
+			r = r.WithContext(context.WithValue(r.Context(), adminKey{}, true))
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// IsAdmin checks the request of ctx bears the admin token
+func IsAdmin(ctx context.Context) bool {
+	admin, _ := ctx.Value(adminKey{}).(bool)
+	return admin
+}
@@ -0,0 +1,33 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdminAuth(t *testing.T) {
+	isAdmin := func(token, header string) bool {
+		var admin bool
+		handler := AdminAuth(token, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			admin = IsAdmin(r.Context())
+		}))
+		req := httptest.NewRequest(http.MethodPost, "/query", nil)
+		if header != "" {
+			req.Header.Set("Authorization", header)
+		}
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+		return admin
+	}
+	assert.True(t, isAdmin("secret", "Bearer secret"))
+	assert.False(t, isAdmin("secret", "Bearer other"))
+	assert.False(t, isAdmin("secret", ""))
+	assert.False(t, isAdmin("secret", "secret"))
+	assert.False(t, isAdmin("", ""))
+	assert.False(t, isAdmin("", "Bearer "))
+}